@@ -0,0 +1,104 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DefaultAddress returns the address the god daemon listens on unless
+// another one is specified: the Unix socket god.sock in $XDG_RUNTIME_DIR,
+// or in a per-user directory of os.TempDir if it is not set.
+// On Windows, it is the loopback TCP port 7154.
+func DefaultAddress() string {
+	if runtime.GOOS == "windows" {
+		return "127.0.0.1:7154" // g: 7, o: 15, d: 4
+	}
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), fmt.Sprintf("god-%d", os.Getuid()))
+	}
+	return filepath.Join(dir, "god.sock")
+}
+
+// ResolveAddress returns the network and address denoted by addr:
+// DefaultAddress if addr is empty, a Unix socket if addr has the prefix
+// "unix:" or contains a slash, and a TCP address otherwise.
+func ResolveAddress(addr string) (network, address string) {
+	if addr == "" {
+		addr = DefaultAddress()
+	}
+	switch {
+	case strings.HasPrefix(addr, "unix:"):
+		return "unix", strings.TrimPrefix(addr, "unix:")
+	case strings.HasPrefix(addr, "tcp:"):
+		return "tcp", strings.TrimPrefix(addr, "tcp:")
+	case strings.Contains(addr, "/"):
+		return "unix", addr
+	}
+	return "tcp", addr
+}
+
+// Listen listens on addr, as resolved by ResolveAddress.  A Unix socket
+// is only accessible to the current user, and replaces the socket of a
+// daemon that is no longer running.  Its directory is created if needed,
+// and must be owned by the current user and writable by no other.
+func Listen(addr string) (net.Listener, error) {
+	network, address := ResolveAddress(addr)
+	if network != "unix" {
+		return net.Listen(network, address)
+	}
+
+	dir := filepath.Dir(address)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	if err := checkSocketDir(dir); err != nil {
+		return nil, err
+	}
+
+	if conn, err := net.Dial(network, address); err == nil {
+		conn.Close()
+		return nil, fmt.Errorf("god daemon is already listening on %s", address)
+	}
+	os.Remove(address) // stale socket
+	lis, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(address, 0600); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	return lis, nil
+}
+
+// Dial returns a client connection to the god daemon at addr, as
+// resolved by ResolveAddress.  It only connects to a Unix socket owned by
+// the current user.
+func Dial(ctx context.Context, addr string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	network, address := ResolveAddress(addr)
+	opts = append([]grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithDialer(func(address string, timeout time.Duration) (net.Conn, error) {
+			if network == "unix" {
+				if err := checkSocket(address); err != nil {
+					return nil, err
+				}
+			}
+			return net.DialTimeout(network, address, timeout)
+		}),
+	}, opts...)
+	return grpc.DialContext(ctx, address, opts...)
+}
@@ -0,0 +1,145 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestResolveAddress(t *testing.T) {
+	defer os.Setenv("XDG_RUNTIME_DIR", os.Getenv("XDG_RUNTIME_DIR"))
+	os.Setenv("XDG_RUNTIME_DIR", "/run/user/1000")
+
+	tests := []struct {
+		addr             string
+		network, address string
+	}{
+		{"", "unix", "/run/user/1000/god.sock"},
+		{"/tmp/god.sock", "unix", "/tmp/god.sock"},
+		{"./god.sock", "unix", "./god.sock"},
+		{"unix:god.sock", "unix", "god.sock"},
+		{"localhost:7154", "tcp", "localhost:7154"},
+		{":7154", "tcp", ":7154"},
+		{"tcp:localhost:7154", "tcp", "localhost:7154"},
+	}
+	for _, test := range tests {
+		if runtime.GOOS == "windows" && test.addr == "" {
+			continue
+		}
+		network, address := ResolveAddress(test.addr)
+		if network != test.network || address != test.address {
+			t.Errorf("ResolveAddress(%q) = %s, %s; want %s, %s",
+				test.addr, network, address, test.network, test.address)
+		}
+	}
+}
+
+func TestListenUnix(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("no Unix sockets")
+	}
+	dir, err := ioutil.TempDir("", "god-addr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	addr := filepath.Join(dir, "run", "god.sock")
+
+	lis, err := Listen(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi, err := os.Stat(addr); err != nil {
+		t.Fatal(err)
+	} else if perm := fi.Mode().Perm(); perm != 0600 {
+		t.Errorf("socket permissions %v, want 0600", perm)
+	}
+	if fi, err := os.Stat(filepath.Dir(addr)); err != nil {
+		t.Fatal(err)
+	} else if perm := fi.Mode().Perm(); perm != 0700 {
+		t.Errorf("directory permissions %v, want 0700", perm)
+	}
+	if _, err := Listen(addr); err == nil {
+		t.Errorf("second Listen succeeded while the first is listening")
+	}
+
+	// A client reaches the server through the socket.
+	s := NewServer()
+	defer s.closeWorkspaces()
+	go s.grpcs.Serve(lis)
+	conn, err := Dial(context.Background(), addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewClient(conn).Ping(context.Background()); err != nil {
+		t.Errorf("Ping: %v", err)
+	}
+	conn.Close()
+	s.grpcs.Stop()
+
+	// The socket of a daemon that died is replaced.
+	lis, err = net.Listen("unix", addr+".stale")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis.(*net.UnixListener).SetUnlinkOnClose(false)
+	lis.Close()
+	if lis, err = Listen(addr + ".stale"); err != nil {
+		t.Errorf("Listen on stale socket: %v", err)
+	} else {
+		lis.Close()
+	}
+
+	// A directory writable by other users is refused.
+	shared := filepath.Join(dir, "shared")
+	if err := os.Mkdir(shared, 0777); err != nil {
+		t.Fatal(err)
+	}
+	os.Chmod(shared, 0777) // despite umask
+	if lis, err := Listen(filepath.Join(shared, "god.sock")); err == nil {
+		lis.Close()
+		t.Errorf("Listen in a world-writable directory succeeded")
+	}
+
+	// A directory only readable by other users, e.g. $HOME, is not.
+	if err := os.Chmod(shared, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if lis, err := Listen(filepath.Join(shared, "god.sock")); err != nil {
+		t.Errorf("Listen in a directory readable by other users: %v", err)
+	} else {
+		lis.Close()
+	}
+
+	// A symbolic link to a private directory is refused.
+	link := filepath.Join(dir, "link")
+	if err := os.Symlink(filepath.Dir(addr), link); err != nil {
+		t.Fatal(err)
+	}
+	if lis, err := Listen(filepath.Join(link, "god.sock")); err == nil {
+		lis.Close()
+		t.Errorf("Listen in a symbolic link to a directory succeeded")
+	}
+
+	// A client refuses a symbolic link to the socket, which another
+	// user could have made.
+	if lis, err = Listen(addr); err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	if err := os.Symlink(addr, filepath.Join(dir, "god.sock")); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := Connect(context.Background(), filepath.Join(dir, "god.sock"), WithoutStart()); err == nil {
+		c.Close()
+		t.Errorf("Connect to a symbolic link to the socket succeeded")
+	}
+}
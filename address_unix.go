@@ -0,0 +1,59 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !windows
+
+package god
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// checkSocketDir returns an error unless dir is a directory, not a
+// symbolic link to one, that belongs to the current user and that no
+// other user can write to.
+func checkSocketDir(dir string) error {
+	fi, err := lstatOwned(dir)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	if perm := fi.Mode().Perm(); perm&0022 != 0 {
+		return fmt.Errorf("%s is writable by other users", dir)
+	}
+	return nil
+}
+
+// checkSocket returns an error unless the Unix socket name, not a
+// symbolic link to one, belongs to the current user.
+func checkSocket(name string) error {
+	fi, err := lstatOwned(name)
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s is not a socket", name)
+	}
+	return nil
+}
+
+// lstatOwned returns the FileInfo of name, which must not be a symbolic
+// link and must be owned by the current user.
+func lstatOwned(name string) (os.FileInfo, error) {
+	fi, err := os.Lstat(name)
+	if err != nil {
+		return nil, err
+	}
+	if fi.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("%s is a symbolic link", name)
+	}
+	if st, ok := fi.Sys().(*syscall.Stat_t); !ok || int(st.Uid) != os.Getuid() {
+		return nil, fmt.Errorf("%s is not owned by the current user", name)
+	}
+	return fi, nil
+}
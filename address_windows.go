@@ -0,0 +1,17 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+// checkSocketDir does nothing: on Windows the daemon listens on a TCP
+// port rather than on a Unix socket.
+func checkSocketDir(dir string) error {
+	return nil
+}
+
+// checkSocket does nothing: on Windows the daemon listens on a TCP port
+// rather than on a Unix socket.
+func checkSocket(name string) error {
+	return nil
+}
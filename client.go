@@ -6,7 +6,18 @@ package god
 
 import (
 	"context"
+	"fmt"
+	"go/build"
+	"io"
 	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/zchee/god/internal/log"
 	serialpb "github.com/zchee/god/serial"
@@ -20,10 +31,6 @@ type Client struct {
 	grpcc serialpb.GodClient
 }
 
-type ClientOptions struct {
-	Scope string
-}
-
 func init() {
 	// disable the annoying grpclog output.
 	grpclog.SetLogger(log.New(ioutil.Discard, "", 0))
@@ -37,149 +44,388 @@ func NewClient(conn *grpc.ClientConn) *Client {
 	}
 }
 
-// Callees return the callees information of current cursor position.
-func (c *Client) Callees(ctx context.Context, pos string, opt *ClientOptions) {
-	loc := &serialpb.Location{Pos: pos}
-	if opt != nil {
-		loc.Options = &serialpb.Options{
-			Scope: opt.Scope,
-		}
+// A ConnectOption configures Connect.
+type ConnectOption func(*connectOptions)
+
+type connectOptions struct {
+	daemon []string // command that starts the daemon, without -d and -addr
+	start  bool
+}
+
+// WithDaemon sets the command that Connect runs to start the daemon:
+// the god executable at path, with the daemon flags args.  By default,
+// Connect runs the god found in $PATH.
+func WithDaemon(path string, args ...string) ConnectOption {
+	return func(o *connectOptions) {
+		o.daemon = append([]string{path}, args...)
 	}
-	callees, err := c.grpcc.GetCallees(ctx, loc)
-	if err != nil {
-		log.Fatalf("could not get Callees: %v", err)
+}
+
+// WithoutStart makes Connect fail instead of starting the daemon if it
+// is not running.
+func WithoutStart() ConnectOption {
+	return func(o *connectOptions) {
+		o.start = false
 	}
-	log.Debugf("callees: %T => %+v\n", callees, callees)
 }
 
-// Callers return the callers information of current cursor position.
-func (c *Client) Callers(ctx context.Context, pos string, opt *ClientOptions) {
-	loc := &serialpb.Location{Pos: pos}
-	if opt != nil {
-		loc.Options = &serialpb.Options{
-			Scope: opt.Scope,
+// Connect returns a client of the god daemon at addr, as resolved by
+// ResolveAddress.  If no daemon is running, Connect starts one in the
+// background and waits until it serves, or until ctx is done.  It fails
+// if the Unix socket of the daemon is not owned by the current user.
+func Connect(ctx context.Context, addr string, opts ...ConnectOption) (*Client, error) {
+	o := &connectOptions{daemon: []string{"god"}, start: true}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	network, address := ResolveAddress(addr)
+	if conn, err := net.Dial(network, address); err == nil {
+		conn.Close()
+	} else if !o.start {
+		return nil, err
+	} else {
+		if err := startDaemon(o.daemon, addr); err != nil {
+			return nil, err
+		}
+		// Wait for the socket rather than for grpc, whose
+		// reconnection backoff would delay the first query.
+		for {
+			conn, err := net.Dial(network, address)
+			if err == nil {
+				conn.Close()
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(10 * time.Millisecond):
+			}
 		}
 	}
-	callers, err := c.grpcc.GetCallers(ctx, loc)
+
+	if network == "unix" {
+		if err := checkSocket(address); err != nil {
+			return nil, err
+		}
+	}
+	conn, err := Dial(ctx, addr)
 	if err != nil {
-		log.Fatalf("could not get Callees: %v", err)
+		return nil, err
 	}
-	log.Debugf("callees: %T => %+v\n", callers, callers)
+	return NewClient(conn), nil
 }
 
-// Callstack return the callers information of current cursor position.
-func (c *Client) Callstack(ctx context.Context, pos string, opt *ClientOptions) {
-	loc := &serialpb.Location{Pos: pos}
-	if opt != nil {
-		loc.Options = &serialpb.Options{
-			Scope: opt.Scope,
-		}
+// startDaemon runs the god daemon command at addr in the background.
+// If another daemon wins the race to addr, the new one exits.
+func startDaemon(command []string, addr string) error {
+	log.Debug("startDaemon")
+	path, err := exec.LookPath(command[0])
+	if err != nil {
+		return err
+	}
+	args := []string{path, "-d"}
+	if addr != "" {
+		args = append(args, "-addr", addr)
 	}
-	callstack, err := c.grpcc.GetCallStack(ctx, loc)
+	args = append(args, command[1:]...)
+	cwd, err := os.Getwd()
 	if err != nil {
-		log.Fatalf("could not get CallStack: %v", err)
+		return err
 	}
-	log.Debugf("callstack: %T => %+v\n", callstack, callstack)
-}
 
-// Definition return the definition information of current cursor position.
-func (c *Client) Definition(ctx context.Context, pos string, opt *ClientOptions) {
-	loc := &serialpb.Location{Pos: pos}
-	if opt != nil {
-		loc.Options = &serialpb.Options{
-			Scope: opt.Scope,
-		}
+	stdin, err := os.Open(os.DevNull)
+	if err != nil {
+		return err
 	}
-	def, err := c.grpcc.GetDefinition(ctx, loc)
+	defer stdin.Close()
+	stdout, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
 	if err != nil {
-		log.Fatalf("could not get Definition: %v", err)
+		return err
 	}
-	log.Debugf("def: %T => %+v\n", def, def)
-}
+	defer stdout.Close()
 
-// Describe return the describe information of current cursor position.
-func (c *Client) Describe(ctx context.Context, pos string, opt *ClientOptions) {
-	loc := &serialpb.Location{Pos: pos}
-	if opt != nil {
-		loc.Options = &serialpb.Options{
-			Scope: opt.Scope,
-		}
+	procAttr := os.ProcAttr{
+		Dir:   cwd,
+		Env:   os.Environ(),
+		Files: []*os.File{stdin, stdout, stdout},
 	}
-	desc, err := c.grpcc.GetDescribe(ctx, loc)
+	proc, err := os.StartProcess(path, args, &procAttr)
 	if err != nil {
-		log.Fatalf("could not get Describe: %v", err)
+		return err
 	}
-	log.Debugf("desc: %T => %+v\n", desc, desc)
+
+	return proc.Release()
 }
 
-// FreeVars return the freevars information of current cursor position.
-func (c *Client) FreeVars(ctx context.Context, pos string, opt *ClientOptions) {
-	loc := &serialpb.Location{Pos: pos}
-	if opt != nil {
-		loc.Options = &serialpb.Options{
-			Scope: opt.Scope,
-		}
+// An Option configures a query.
+type Option func(*serialpb.Location)
+
+// WithScope limits the analysis of the query to the specified packages,
+// e.g. "golang.org/x/tools/cmd/guru,-golang.org/x/tools/cmd/guru/testdata/...".
+func WithScope(pkgs ...string) Option {
+	return func(loc *serialpb.Location) {
+		options(loc).Scope = strings.Join(pkgs, ",")
 	}
-	frs, err := c.grpcc.GetFreeVars(ctx, loc)
-	if err != nil {
-		log.Fatalf("could not get FreeVar: %v", err)
+}
+
+// WithReflection makes the pointer analysis of the query analyze
+// reflection soundly, which is slow.
+func WithReflection() Option {
+	return func(loc *serialpb.Location) {
+		options(loc).Reflection = true
 	}
-	log.Debugf("frs: %T => %+v\n", frs, frs)
 }
 
-// Implements return the implements information of current cursor position.
-func (c *Client) Implements(ctx context.Context, pos string, opt *ClientOptions) {
-	loc := &serialpb.Location{Pos: pos}
-	if opt != nil {
-		loc.Options = &serialpb.Options{
-			Scope: opt.Scope,
+// WithBuildContext runs the query in the build context ctxt, e.g.
+// &build.Default of the client process.  By default, the daemon uses
+// its own.
+func WithBuildContext(ctxt *build.Context) Option {
+	return func(loc *serialpb.Location) {
+		opt := options(loc)
+		opt.GOROOT = ctxt.GOROOT
+		opt.GOPATH = ctxt.GOPATH
+		opt.GOOS = ctxt.GOOS
+		opt.GOARCH = ctxt.GOARCH
+		opt.BuildTags = ctxt.BuildTags
+		opt.CgoEnabled = ctxt.CgoEnabled
+		opt.CgoEnabledSet = true
+	}
+}
+
+// WithOverlay runs the query on the contents of unsaved editor buffers,
+// keyed by file name, as returned by buildutil.ParseOverlayArchive.
+func WithOverlay(overlay map[string][]byte) Option {
+	return func(loc *serialpb.Location) {
+		for filename, content := range overlay {
+			// The daemon may run in another directory.
+			if abs, err := filepath.Abs(filename); err == nil {
+				filename = abs
+			}
+			loc.Overlays = append(loc.Overlays, serialpb.Overlay{
+				Filename: filename,
+				Content:  content,
+			})
 		}
 	}
-	impl, err := c.grpcc.GetImplements(ctx, loc)
+}
+
+// options returns the options of loc, allocating them if needed.
+func options(loc *serialpb.Location) *serialpb.Options {
+	if loc.Options == nil {
+		loc.Options = new(serialpb.Options)
+	}
+	return loc.Options
+}
+
+// SplitPosition splits a position of the results of queries, of the
+// form "file:line:col".  ok is false if pos is not of this form.
+func SplitPosition(pos string) (filename string, line, col int, ok bool) {
+	i := strings.LastIndex(pos, ":")
+	if i < 0 {
+		return "", 0, 0, false
+	}
+	j := strings.LastIndex(pos[:i], ":")
+	if j < 0 {
+		return "", 0, 0, false
+	}
+	line, err1 := strconv.Atoi(pos[j+1 : i])
+	col, err2 := strconv.Atoi(pos[i+1:])
+	if err1 != nil || err2 != nil {
+		return "", 0, 0, false
+	}
+	return pos[:j], line, col, true
+}
+
+// newLocation returns the Location of a query at pos with opts.
+func newLocation(pos string, opts []Option) *serialpb.Location {
+	loc := &serialpb.Location{Pos: absPos(pos)}
+	for _, opt := range opts {
+		opt(loc)
+	}
+	return loc
+}
+
+// posRe matches a query position, capturing its file name.
+var posRe = regexp.MustCompile(`^(.*):(?:#\d+(?:,#\d+)?|\d+:\d+(?:,\d+:\d+)?)$`)
+
+// absPos returns pos with an absolute file name, since the daemon may
+// run in another directory.
+func absPos(pos string) string {
+	m := posRe.FindStringSubmatchIndex(pos)
+	if m == nil || filepath.IsAbs(pos[:m[3]]) {
+		return pos // the daemon reports bad positions
+	}
+	filename, err := filepath.Abs(pos[:m[3]])
 	if err != nil {
-		log.Fatalf("could not get Implements: %v", err)
+		return pos
 	}
-	log.Debugf("impl: %T => %+v\n", impl, impl)
+	return filename + pos[m[3]:]
+}
+
+// Callees returns the possible callees of the function call at pos.
+func (c *Client) Callees(ctx context.Context, pos string, opts ...Option) (*serialpb.Callees, error) {
+	return c.grpcc.GetCallees(ctx, newLocation(pos, opts))
+}
+
+// Callers returns the possible callers of the function containing pos.
+func (c *Client) Callers(ctx context.Context, pos string, opts ...Option) (*serialpb.Callers, error) {
+	return c.grpcc.GetCallers(ctx, newLocation(pos, opts))
+}
+
+// Callstack returns a path from the root of the call graph to the
+// function containing pos.
+func (c *Client) Callstack(ctx context.Context, pos string, opts ...Option) (*serialpb.CallStack, error) {
+	return c.grpcc.GetCallStack(ctx, newLocation(pos, opts))
+}
+
+// Definition returns the declaration of the identifier at pos.
+func (c *Client) Definition(ctx context.Context, pos string, opts ...Option) (*serialpb.Definition, error) {
+	return c.grpcc.GetDefinition(ctx, newLocation(pos, opts))
+}
+
+// Describe describes the syntax at pos.
+func (c *Client) Describe(ctx context.Context, pos string, opts ...Option) (*serialpb.Describe, error) {
+	return c.grpcc.GetDescribe(ctx, newLocation(pos, opts))
+}
+
+// FreeVars returns the free variables of the selection at pos.
+func (c *Client) FreeVars(ctx context.Context, pos string, opts ...Option) (*serialpb.FreeVars, error) {
+	return c.grpcc.GetFreeVars(ctx, newLocation(pos, opts))
+}
+
+// Implements returns the implements relation for the type or method at pos.
+func (c *Client) Implements(ctx context.Context, pos string, opts ...Option) (*serialpb.Implements, error) {
+	return c.grpcc.GetImplements(ctx, newLocation(pos, opts))
+}
+
+// Peers returns the sends and receives on the channel of the operation at pos.
+func (c *Client) Peers(ctx context.Context, pos string, opts ...Option) (*serialpb.Peers, error) {
+	return c.grpcc.GetPeers(ctx, newLocation(pos, opts))
+}
+
+// PointsTo returns what the pointer-like expression at pos may point to.
+func (c *Client) PointsTo(ctx context.Context, pos string, opts ...Option) (*serialpb.PointsTos, error) {
+	return c.grpcc.GetPointsTo(ctx, newLocation(pos, opts))
+}
+
+// Referrers returns all the references to the object at pos.
+func (c *Client) Referrers(ctx context.Context, pos string, opts ...Option) (*serialpb.ReferrersPackage, error) {
+	return c.grpcc.GetReferrers(ctx, newLocation(pos, opts))
 }
 
-// Peers return the peers information of current cursor position.
-func (c *Client) Peers(ctx context.Context, pos string, opt *ClientOptions) {
-	loc := &serialpb.Location{Pos: pos}
-	if opt != nil {
-		loc.Options = &serialpb.Options{
-			Scope: opt.Scope,
+// StreamReferrers streams the references to the object at pos, calling
+// fn with each event as soon as it arrives.
+func (c *Client) StreamReferrers(ctx context.Context, pos string, fn func(*serialpb.ReferrersEvent), opts ...Option) error {
+	stream, err := c.grpcc.StreamReferrers(ctx, newLocation(pos, opts))
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
 		}
+		fn(event)
 	}
-	peers, err := c.grpcc.GetPeers(ctx, loc)
+}
+
+// What returns basic information about the syntax at pos.
+func (c *Client) What(ctx context.Context, pos string, opts ...Option) (*serialpb.What, error) {
+	return c.grpcc.GetWhat(ctx, newLocation(pos, opts))
+}
+
+// WhichErrs returns the possible values of the error variable at pos.
+func (c *Client) WhichErrs(ctx context.Context, pos string, opts ...Option) (*serialpb.WhichErrs, error) {
+	return c.grpcc.GetWhichErrs(ctx, newLocation(pos, opts))
+}
+
+// Print runs the query of the specified mode at pos and writes its
+// output to w in format: "plain" (as guru prints it), "json" (as guru
+// -json prints it), "emacs" or "vim".
+func (c *Client) Print(ctx context.Context, w io.Writer, mode, pos, format string, opts ...Option) error {
+	stream, err := c.grpcc.Print(ctx, &serialpb.Query{
+		Mode:     mode,
+		Location: newLocation(pos, opts),
+		Format:   format,
+	})
 	if err != nil {
-		log.Fatalf("could not get Peers: %v", err)
+		return err
+	}
+	for {
+		out, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(out.Text); err != nil {
+			return err
+		}
 	}
-	log.Debugf("peers: %T => %+v\n", peers, peers)
 }
 
-// PointsTo return the pointsTo information of current cursor position.
-func (c *Client) PointsTo(ctx context.Context, pos string, opt *ClientOptions) {
-	loc := &serialpb.Location{Pos: pos}
-	if opt != nil {
-		loc.Options = &serialpb.Options{
-			Scope: opt.Scope,
-		}
+// Batch runs the queries of items at once, with opts, and returns their
+// results in the order of items, each with its output in format, as for
+// Print, or its error.  Batch fails only if the batch does.
+func (c *Client) Batch(ctx context.Context, items []serialpb.BatchItem, format string, opts ...Option) ([]serialpb.BatchResult, error) {
+	loc := newLocation("", opts)
+	req := &serialpb.BatchRequest{
+		Items:    make([]serialpb.BatchItem, len(items)),
+		Options:  loc.Options,
+		Overlays: loc.Overlays,
+		Format:   format,
 	}
-	pointsTo, err := c.grpcc.GetPointsTo(ctx, loc)
+	for i, item := range items {
+		req.Items[i] = serialpb.BatchItem{Mode: item.Mode, Pos: absPos(item.Pos)}
+	}
+	stream, err := c.grpcc.Batch(ctx, req)
 	if err != nil {
-		log.Fatalf("could not get PointsTo: %v", err)
+		return nil, err
 	}
-	log.Debugf("pointsTo: %T => %+v\n", pointsTo, pointsTo)
+	results := make([]serialpb.BatchResult, len(items))
+	for n := 0; n < len(items); n++ {
+		result, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("got %d results of %d queries", n, len(items))
+			}
+			return nil, err
+		}
+		if result.Index < 0 || result.Index >= int64(len(items)) {
+			return nil, fmt.Errorf("result of unknown query %d", result.Index)
+		}
+		results[result.Index] = *result
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		if err == nil {
+			err = fmt.Errorf("got more results than %d queries", len(items))
+		}
+		return nil, err
+	}
+	return results, nil
+}
+
+// Ping checks that the god daemon is serving.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.grpcc.Ping(ctx, &serialpb.Request{})
+	return err
 }
 
-func (c *Client) Ping() (*serialpb.Response, error) {
-	log.Debugln("Ping")
-	return c.grpcc.Ping(context.Background(), &serialpb.Request{})
+// Status describes what the god daemon holds and is doing.
+func (c *Client) Status(ctx context.Context) (*serialpb.DaemonStatus, error) {
+	return c.grpcc.Status(ctx, &serialpb.Request{})
 }
 
-// Stop sends stop signal to god gRPC server.
-func (c *Client) Stop() {
+// Stop asks the god daemon to exit once the queries in progress are done.
+func (c *Client) Stop(ctx context.Context) error {
 	log.Debugln("Stop")
+	_, err := c.grpcc.Shutdown(ctx, &serialpb.Request{})
+	return err
 }
 
 // Close closes the grpc ClientConn.
@@ -0,0 +1,94 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClient(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	gopath := filepath.Dir(filepath.Dir(filepath.Dir(filename)))
+	s.Addr = filepath.Join(gopath, "god.sock")
+
+	ctx := context.Background()
+	if _, err := Connect(ctx, s.Addr, WithoutStart()); err == nil {
+		t.Fatal("Connect without a daemon succeeded")
+	}
+
+	_, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+	c, err := Connect(ctx, s.Addr, WithoutStart())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	pos := func(src, sel string) string {
+		return fmt.Sprintf("%s:#%d", filename, strings.Index(src, sel))
+	}
+	ctxt := *s.ctxt // GOPATH set by newTestServer
+	def, err := c.Definition(ctx, pos(testSrc, "a\n"), WithBuildContext(&ctxt), WithScope("p"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filename + ":7:5"; def.ObjPos != want {
+		t.Errorf("Definition: got %s, want %s", def.ObjPos, want)
+	}
+
+	// Overlays replace the file on disk.
+	modified := strings.Replace(testSrc, `var b = "b"`, "var b = 1.5", 1)
+	overlay := map[string][]byte{filename: []byte(modified)}
+	desc, err := c.Describe(ctx, pos(modified, "b ="), WithOverlay(overlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Value == nil || desc.Value.Type != "float64" {
+		t.Errorf("Describe with overlay: got %+v, want type float64", desc.Value)
+	}
+
+	// Errors are returned to the caller.
+	if _, err := c.Describe(ctx, filename+":#100000"); err == nil {
+		t.Errorf("Describe at invalid position succeeded")
+	}
+
+	if err := c.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errc; err != nil {
+		t.Errorf("Start: %v", err)
+	}
+}
+
+func TestAbsPos(t *testing.T) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	abs := filepath.Join(cwd, "p.go")
+	tests := []struct {
+		pos, want string
+	}{
+		{"p.go:#12", abs + ":#12"},
+		{"p.go:#12,#15", abs + ":#12,#15"},
+		{"p.go:3:4", abs + ":3:4"},
+		{"p.go:3:4,5:6", abs + ":3:4,5:6"},
+		{abs + ":#12", abs + ":#12"},
+		{"p.go", "p.go"},
+		{"p.go:12", "p.go:12"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if got := absPos(test.pos); got != test.want {
+			t.Errorf("absPos(%q) = %q, want %q", test.pos, got, test.want)
+		}
+	}
+}
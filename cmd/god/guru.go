@@ -0,0 +1,218 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"go/build"
+	"go/token"
+	"io"
+	"os"
+	"runtime/pprof"
+	"strings"
+	"sync"
+
+	"github.com/zchee/god"
+	"github.com/zchee/god/internal/guru"
+
+	"golang.org/x/tools/go/buildutil"
+	"google.golang.org/grpc"
+)
+
+const guruUseHelp = "Run 'guru -help' for more information.\n"
+
+// guruHelp is the help message of guru.
+const guruHelp = `Go source code guru.
+Usage: guru [flags] <mode> <position>
+
+The mode argument determines the query to perform:
+
+	callees	  	show possible targets of selected function call
+	callers	  	show possible callers of selected function
+	callstack 	show path from callgraph root to selected function
+	definition	show declaration of selected identifier
+	describe  	describe selected syntax: definition, methods, etc
+	freevars  	show free variables of selection
+	implements	show 'implements' relation for selected type or method
+	peers     	show send/receive corresponding to selected channel op
+	pointsto	show variables the selected pointer may point to
+	referrers 	show all refs to entity denoted by selected identifier
+	what		show basic information about the selected syntax node
+	whicherrs	show possible values of the selected error variable
+
+The position argument specifies the filename and byte offset (or range)
+of the syntax element to query.  For example:
+
+	foo.go:#123,#128
+	bar.go:#123
+
+The -json flag causes guru to emit output in JSON format;
+	golang.org/x/tools/cmd/guru/serial defines its schema.
+	Otherwise, the output is in an editor-friendly format in which
+	every line has the form "pos: text", where pos is "-" if unknown.
+
+The -modified flag causes guru to read an archive from standard input.
+	Files in this archive will be used in preference to those in
+	the file system.  In this way, a text editor may supply guru
+	with the contents of its unsaved buffers.  Each archive entry
+	consists of the file name, a newline, the decimal file size,
+	another newline, and the contents of the file.
+
+The -scope flag restricts analysis to the specified packages.
+	Its value is a comma-separated list of patterns of these forms:
+		golang.org/x/tools/cmd/guru     # a single package
+		golang.org/x/tools/...          # all packages beneath dir
+		...                             # the entire workspace.
+	A pattern preceded by '-' is negative, so the scope
+		encoding/...,-encoding/xml
+	matches all encoding packages except encoding/xml.
+
+User manual: http://golang.org/s/using-guru
+
+Example: describe syntax at offset 530 in this file (an import spec):
+
+  $ guru describe src/golang.org/x/tools/cmd/guru/main.go:#530
+`
+
+// guruMain runs god as a drop-in replacement for the guru command, with
+// the command-line arguments args, and returns its exit status.  It
+// accepts the flags of guru and prints the same output and errors, but
+// sends the queries to the god daemon returned by connect.
+//
+// Relative file names are resolved in the current directory, and appear
+// as absolute ones in error messages.  The points-to analysis log and
+// the CPU profile are about the analysis itself, so queries with -ptalog
+// or -cpuprofile run in this process.
+func guruMain(args []string, stdin io.Reader, stdout, stderr io.Writer, connect func(context.Context) (*god.Client, error)) int {
+	flags := flag.NewFlagSet("guru", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var (
+		modifiedFlag   = flags.Bool("modified", false, "read archive of modified files from standard input")
+		scopeFlag      = flags.String("scope", "", "comma-separated list of `packages` the analysis should be limited to")
+		ptalogFlag     = flags.String("ptalog", "", "write points-to analysis log to `file`")
+		jsonFlag       = flags.Bool("json", false, "emit output in JSON format")
+		reflectFlag    = flags.Bool("reflect", false, "analyze reflection soundly (slow)")
+		cpuprofileFlag = flags.String("cpuprofile", "", "write CPU profile to `file`")
+	)
+	ctxt := build.Default
+	flags.Var((*buildutil.TagsFlag)(&ctxt.BuildTags), "tags", buildutil.TagsFlagDoc)
+
+	printHelp := func() {
+		fmt.Fprintf(stderr, "%s\n", guruHelp)
+		fmt.Fprintln(stderr, "Flags:")
+		flags.PrintDefaults()
+	}
+	fatal := func(v ...interface{}) int {
+		fmt.Fprintln(stderr, "guru:", fmt.Sprint(v...))
+		return 1
+	}
+
+	// Don't print full help unless -help was requested.
+	flags.Usage = func() { fmt.Fprint(stderr, guruUseHelp) }
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			printHelp()
+		}
+		return 2
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+	mode, posn := flags.Arg(0), flags.Arg(1)
+	if mode == "help" {
+		printHelp()
+		return 2
+	}
+
+	var modified map[string][]byte
+	if *modifiedFlag {
+		var err error
+		if modified, err = buildutil.ParseOverlayArchive(stdin); err != nil {
+			return fatal(err)
+		}
+	}
+	var scope []string
+	if *scopeFlag != "" {
+		scope = strings.Split(*scopeFlag, ",")
+	}
+
+	if *ptalogFlag != "" || *cpuprofileFlag != "" {
+		var ptalog io.Writer
+		if *ptalogFlag != "" {
+			f, err := os.Create(*ptalogFlag)
+			if err != nil {
+				return fatal(fmt.Sprintf("Failed to create PTA log file: %s", err))
+			}
+			defer f.Close()
+			buf := bufio.NewWriter(f)
+			defer buf.Flush()
+			ptalog = buf
+		}
+		if *cpuprofileFlag != "" {
+			f, err := os.Create(*cpuprofileFlag)
+			if err != nil {
+				return fatal(err)
+			}
+			defer f.Close()
+			pprof.StartCPUProfile(f)
+			defer pprof.StopCPUProfile()
+		}
+
+		var outputMu sync.Mutex
+		query := &guru.Query{
+			Pos:        posn,
+			Build:      &ctxt,
+			Scope:      scope,
+			PTALog:     ptalog,
+			Reflection: *reflectFlag,
+			Output: func(fset *token.FileSet, qr guru.QueryResult) {
+				outputMu.Lock()
+				defer outputMu.Unlock()
+				if *jsonFlag {
+					fmt.Fprintf(stdout, "%s\n", qr.JSON(fset))
+				} else {
+					guru.PrintPlain(stdout, fset, qr, guru.Emacs)
+				}
+			},
+		}
+		if len(modified) > 0 {
+			query.Build = buildutil.OverlayContext(query.Build, modified)
+		}
+		if err := guru.Run(mode, query); err != nil {
+			return fatal(err)
+		}
+		return 0
+	}
+
+	ctx := context.Background()
+	c, err := connect(ctx)
+	if err != nil {
+		return fatal("could not connect: ", err)
+	}
+	defer c.Close()
+
+	opts := []god.Option{god.WithBuildContext(&ctxt)}
+	if *scopeFlag != "" {
+		opts = append(opts, god.WithScope(*scopeFlag))
+	}
+	if *reflectFlag {
+		opts = append(opts, god.WithReflection())
+	}
+	if len(modified) > 0 {
+		opts = append(opts, god.WithOverlay(modified))
+	}
+	format := "plain"
+	if *jsonFlag {
+		format = "json"
+	}
+	if err := c.Print(ctx, stdout, mode, posn, format, opts...); err != nil {
+		return fatal(grpc.ErrorDesc(err))
+	}
+	return 0
+}
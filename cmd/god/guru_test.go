@@ -0,0 +1,247 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/build"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zchee/god"
+	"github.com/zchee/god/internal/guru"
+
+	"golang.org/x/tools/go/buildutil"
+)
+
+// guruTestdata is the GOPATH of the guru tests, whose queries are the
+// oracle of the compatibility of god guru.
+var guruTestdata = filepath.Join("..", "..", "internal", "guru", "testdata")
+
+// A guruQuery is a query annotation of the guru tests, of the form
+// @mode id "select".
+type guruQuery struct {
+	mode, id string
+	pos      string // in guru syntax
+	pkg      string
+}
+
+var queryRe = regexp.MustCompile(`// @([a-z]+)\s+(\S+)\s+(".*")$`)
+
+// parseQueries returns the queries of the named file of the guru tests
+// whose mode is one of modes.
+func parseQueries(t *testing.T, filename string, modes ...string) []guruQuery {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var queries []guruQuery
+	offset := 0
+	for _, line := range strings.Split(string(content), "\n") {
+		lineOffset := offset
+		offset += len(line) + 1
+		m := queryRe.FindStringSubmatchIndex(line)
+		if m == nil || m[3]-m[2] == 0 {
+			continue
+		}
+		mode, id := line[m[2]:m[3]], line[m[4]:m[5]]
+		if !contains(modes, mode) || line[m[6]:m[7]] == `"nopos"` {
+			continue
+		}
+		pattern, err := strconv.Unquote(line[m[6]:m[7]])
+		if err != nil {
+			t.Fatalf("%s: @%s %s: %v", filename, mode, id, err)
+		}
+		sel := regexp.MustCompile(pattern).FindStringIndex(line[:m[0]])
+		if sel == nil {
+			t.Fatalf("%s: @%s %s: selection not found", filename, mode, id)
+		}
+		queries = append(queries, guruQuery{
+			mode: mode,
+			id:   id,
+			pos:  fmt.Sprintf("%s:#%d,#%d", filename, lineOffset+sel[0], lineOffset+sel[1]),
+			pkg:  filepath.Base(filepath.Dir(filename)),
+		})
+	}
+	return queries
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// runGuru runs the query of mode at pos in this process, as the guru
+// command would with the specified flags, and returns its output and
+// errors.
+func runGuru(mode, pos, scope string, json bool, modified map[string][]byte) (stdout, stderr string) {
+	var (
+		mu  sync.Mutex
+		out bytes.Buffer
+	)
+	q := &guru.Query{
+		Pos:   pos,
+		Build: &build.Default,
+		Output: func(fset *token.FileSet, qr guru.QueryResult) {
+			mu.Lock()
+			defer mu.Unlock()
+			if json {
+				fmt.Fprintf(&out, "%s\n", qr.JSON(fset))
+			} else {
+				guru.PrintPlain(&out, fset, qr, guru.Emacs)
+			}
+		},
+	}
+	if scope != "" {
+		q.Scope = strings.Split(scope, ",")
+	}
+	if len(modified) > 0 {
+		q.Build = buildutil.OverlayContext(q.Build, modified)
+	}
+	if err := guru.Run(mode, q); err != nil {
+		return out.String(), fmt.Sprintf("guru: %v\n", err)
+	}
+	return out.String(), ""
+}
+
+func TestGuru(t *testing.T) {
+	gopath, err := filepath.Abs(guruTestdata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "god-guru")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer func(gopath, cacheHome string) {
+		build.Default.GOPATH = gopath
+		os.Setenv("XDG_CACHE_HOME", cacheHome)
+	}(build.Default.GOPATH, os.Getenv("XDG_CACHE_HOME"))
+	build.Default.GOPATH = gopath
+	os.Setenv("XDG_CACHE_HOME", filepath.Join(dir, "cache"))
+
+	daemon := god.NewServer()
+	daemon.Addr = filepath.Join(dir, "god.sock")
+	errc := make(chan error, 1)
+	go func() { errc <- daemon.Start() }()
+	defer func() {
+		daemon.Stop()
+		if err := <-errc; err != nil {
+			t.Errorf("daemon: %v", err)
+		}
+	}()
+	connect := func(ctx context.Context) (*god.Client, error) {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		for {
+			c, err := god.Connect(ctx, daemon.Addr, god.WithoutStart())
+			if err == nil || ctx.Err() != nil {
+				return c, err
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// check runs god guru with args and compares its output with that
+	// of guru.
+	check := func(args []string, stdin string, wantStdout, wantStderr string, wantStatus int) {
+		var stdout, stderr bytes.Buffer
+		status := guruMain(args, strings.NewReader(stdin), &stdout, &stderr, connect)
+		got := stdout.String()
+		if len(args) >= 2 && args[len(args)-2] == "referrers" {
+			// Packages are reported in any order.
+			got, wantStdout = sortLines(got), sortLines(wantStdout)
+		}
+		if got != wantStdout {
+			t.Errorf("guru %s: got output\n%s\nwant\n%s", strings.Join(args, " "), got, wantStdout)
+		}
+		if stderr.String() != wantStderr || status != wantStatus {
+			t.Errorf("guru %s: got errors %q and exit status %d, want %q and %d",
+				strings.Join(args, " "), stderr.String(), status, wantStderr, wantStatus)
+		}
+	}
+
+	// The queries that need no pointer analysis, which would be slow.
+	modes := []string{"definition", "describe", "freevars", "implements", "referrers", "what"}
+	n := 0
+	for _, name := range []string{
+		"definition-json/main.go",
+		"describe/main.go",
+		"describe-json/main.go",
+		"freevars/main.go",
+		"implements/main.go",
+		"implements-json/main.go",
+		"implements-methods/main.go",
+		"implements-methods-json/main.go",
+		"imports/main.go",
+		"referrers/main.go",
+		"referrers-json/main.go",
+		"what/main.go",
+		"what-json/main.go",
+	} {
+		json := strings.Contains(name, "-json/")
+		for _, q := range parseQueries(t, filepath.Join(gopath, "src", name), modes...) {
+			args := []string{"-scope=" + q.pkg, q.mode, q.pos}
+			if json {
+				args = append([]string{"-json"}, args...)
+			}
+			stdout, stderr := runGuru(q.mode, q.pos, q.pkg, json, nil)
+			status := 0
+			if stderr != "" {
+				status = 1
+			}
+			check(args, "", stdout, stderr, status)
+			n++
+		}
+	}
+	if n < 100 {
+		t.Errorf("ran %d queries of the guru tests, want at least 100", n)
+	}
+
+	// Unsaved changes.
+	filename := filepath.Join(gopath, "src", "what", "main.go")
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	modified := append([]byte("// An unsaved comment.\n"), content...)
+	pos := fmt.Sprintf("%s:#%d", filename, bytes.Index(modified, []byte("func main")))
+	stdout, _ := runGuru("describe", pos, "", true, map[string][]byte{filename: modified})
+	archive := fmt.Sprintf("%s\n%d\n%s", filename, len(modified), modified)
+	check([]string{"-json", "-modified", "describe", pos}, archive, stdout, "", 0)
+
+	// Errors.
+	readme := filepath.Join(gopath, "src", "README.txt")
+	check([]string{"freevars", readme + ":#1"}, "", "",
+		"guru: "+readme+" is not a Go source file\n", 1)
+	_, stderr := runGuru("nonesuch", pos, "", false, nil)
+	check([]string{"nonesuch", pos}, "", "", stderr, 1)
+	check([]string{"-nonesuch", "describe", pos}, "", "",
+		"flag provided but not defined: -nonesuch\nRun 'guru -help' for more information.\n", 2)
+	check([]string{"describe"}, "", "", "Run 'guru -help' for more information.\n", 2)
+}
+
+func sortLines(s string) string {
+	lines := strings.SplitAfter(s, "\n")
+	sort.Strings(lines)
+	return strings.Join(lines, "")
+}
@@ -6,16 +6,23 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"go/build"
-	"net"
+	"io"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime/pprof"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/zchee/god"
 	"github.com/zchee/god/internal/log"
+	"github.com/zchee/god/internal/lsp"
+	serialpb "github.com/zchee/god/serial"
 
 	"golang.org/x/tools/go/buildutil"
 	"google.golang.org/grpc"
@@ -25,14 +32,62 @@ var (
 	daemonize  = flag.Bool("d", false, "run god daemon instead of client")
 	cpuprofile = flag.String("cpuprofile", "", "write CPU profile to file")
 	scope      = flag.String("scope", "", "comma-separated list of packages the analysis should be limited to")
+	modified   = flag.Bool("modified", false, "read archive of modified files from standard input")
+	addr       = flag.String("addr", "", "address of the god daemon: a Unix socket path, or host:port (default "+god.DefaultAddress()+")")
+	format     = flag.String("format", "plain", "output format: plain, json, emacs or vim")
+	idle       = flag.Duration("idle-timeout", time.Hour, "exit the god daemon after this long without queries, or never if 0")
+	debugHTTP  = flag.String("debug-http", "", "serve the traces, events and profiles of the god daemon over HTTP at this `localhost:port`")
 )
 
+const usage = `Usage: god [flags] <mode> <position>
+       god [flags] stop|status
+       god [flags] lsp
+       god [flags] guru [guru flags] <mode> <position>
+
+The guru command accepts the flags of guru and prints the same output,
+for editor plugins that run guru; god also behaves as guru when it is
+run under that name, e.g. through a symbolic link.
+
+The status command describes what the daemon holds and is doing: its
+workspaces, cached programs, cache hits and misses, and the requests in
+progress; with -format=json, in the JSON encoding of serial.DaemonStatus.
+
+The lsp command serves the Language Server Protocol on standard input
+and output, answering definition, references, hover, implementation and
+documentHighlight requests.
+
+With -debug-http, the daemon traces every request and serves the traces
+at /debug/requests, including the load, type-check, SSA and pointer
+analysis phases of its queries, its own events at /debug/events, and
+profiles at /debug/pprof.  A daemon started by a client inherits the
+flag.
+
+The position is a file name followed by a byte offset, or by a line and
+column (1-based, in bytes), optionally extended to a range:
+	file.go:#123  file.go:#123,#456
+	file.go:12:3  file.go:12:3,14:1
+
+Flags:
+`
+
 func init() {
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
 	flag.Var((*buildutil.TagsFlag)(&build.Default.BuildTags), "tags", buildutil.TagsFlagDoc)
-	flag.Parse()
 }
 
 func main() {
+	if name := filepath.Base(os.Args[0]); strings.TrimSuffix(name, ".exe") == "guru" {
+		os.Exit(guruMain(os.Args[1:], os.Stdin, os.Stdout, os.Stderr, connect))
+	}
+	flag.Parse()
+	grpc.EnableTracing = *daemonize && *debugHTTP != ""
+	if flag.NArg() > 0 && flag.Arg(0) == "guru" {
+		os.Exit(guruMain(flag.Args()[1:], os.Stdin, os.Stdout, os.Stderr, connect))
+	}
+
 	if *daemonize {
 		if *cpuprofile != "" {
 			f, err := os.Create(*cpuprofile)
@@ -47,116 +102,216 @@ func main() {
 		signal.Notify(sigc, os.Interrupt, syscall.SIGTERM)
 
 		srv := god.NewServer()
+		srv.Addr = *addr
+		srv.IdleTimeout = *idle
+		srv.DebugAddr = *debugHTTP
 		errc := make(chan error, 1)
 		go func() { errc <- srv.Start() }()
 
 		select {
 		case err := <-errc:
+			if err == god.ErrRunning {
+				log.Debug(err)
+				return
+			}
 			if err != nil {
 				log.Fatal(err)
 			}
 		case <-sigc:
-			return
+			srv.Stop()
+			if err := <-errc; err != nil {
+				log.Fatal(err)
+			}
 		}
+		return
 	}
 
 	args := flag.Args()
-	if len(args) != 2 {
+	daemonCmd := len(args) == 1 && (args[0] == "stop" || args[0] == "status")
+	lspCmd := len(args) == 1 && args[0] == "lsp"
+	if len(args) != 2 && !daemonCmd && !lspCmd {
 		flag.Usage()
 		os.Exit(2)
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	conn, err := grpc.DialContext(ctx, god.Address, grpc.WithInsecure())
+
+	if daemonCmd {
+		conn, err := god.Dial(ctx, *addr)
+		if err != nil {
+			log.Fatalf("could not connect: %v", err)
+		}
+		c := god.NewClient(conn)
+		defer c.Close()
+		switch args[0] {
+		case "stop":
+			stop(ctx, c)
+		case "status":
+			status(ctx, c)
+		}
+		return
+	}
+
+	c, err := connect(ctx)
 	if err != nil {
 		log.Fatalf("could not connect: %v", err)
 	}
-	defer conn.Close()
-
-	c := god.NewClient(conn)
 	defer c.Close()
 
-	if _, err := net.Dial("tcp", god.Address); err != nil {
-		err := runServer()
-		if err != nil {
-			log.Fatal(err)
+	opts := []god.Option{
+		god.WithBuildContext(&build.Default), // with -tags and the environment of the client
+	}
+	if *scope != "" {
+		opts = append(opts, god.WithScope(*scope))
+	}
+	if lspCmd {
+		if *modified {
+			log.Fatal("-modified cannot be used with lsp, which reads standard input")
 		}
-
-		for {
-			if _, err := c.Ping(); err != nil {
-				continue
-			}
-			break
+		if err := lsp.NewServer(c, opts...).Serve(ctx, os.Stdin, os.Stdout); err != nil {
+			log.Fatal(err)
 		}
+		return
 	}
-
-	opt := new(god.ClientOptions)
-	if *scope != "" {
-		opt.Scope = *scope
+	if *modified {
+		overlay, err := buildutil.ParseOverlayArchive(os.Stdin)
+		if err != nil {
+			log.Fatal(err)
+		}
+		opts = append(opts, god.WithOverlay(overlay))
 	}
 
-	cmd := args[0]
+	cmd, pos := args[0], args[1]
 	switch cmd {
-	case "callees":
-		c.Callees(ctx, args[1], opt)
-	case "callers":
-		c.Callers(ctx, args[1], opt)
-	case "callstack":
-		c.Callstack(ctx, args[1], opt)
-	case "definition":
-		c.Definition(ctx, args[1], opt)
-	case "describe":
-		c.Describe(ctx, args[1], opt)
-	case "freevars":
-		c.FreeVars(ctx, args[1], opt)
-	case "implements":
-		c.Implements(ctx, args[1], opt)
-	case "peers":
-		c.Peers(ctx, args[1], opt)
-	case "pointsto":
-		c.PointsTo(ctx, args[1], opt)
-	case "stop":
-		c.Stop()
+	case "callees", "callers", "callstack", "definition", "describe", "freevars",
+		"implements", "peers", "pointsto", "referrers", "what", "whicherrs":
 	default:
 		log.Fatalf("unknown subcommand: %s", cmd)
 	}
+	if err := c.Print(ctx, os.Stdout, cmd, pos, *format, opts...); err != nil {
+		if details := god.ErrorDetails(err); details != nil && len(details.Modes) > 0 {
+			log.Fatalf("could not get %s: %v (try %s)", cmd, err, strings.Join(details.Modes, ", "))
+		}
+		log.Fatalf("could not get %s: %v", cmd, err)
+	}
 }
 
-func runServer() error {
-	log.Debug("runServer")
-	path, err := os.Executable()
+// connect connects to the god daemon at -addr, and starts it if it is
+// not running.
+func connect(ctx context.Context) (*god.Client, error) {
+	exe, err := os.Executable()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	args := []string{path, "-d"}
-	cwd, err := os.Getwd()
-	if err != nil {
-		return err
+	// Not through a link named guru, which would not start a daemon.
+	if exe, err = filepath.EvalSymlinks(exe); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	args := []string{"-idle-timeout", idle.String()}
+	if *debugHTTP != "" {
+		args = append(args, "-debug-http", *debugHTTP)
 	}
+	return god.Connect(ctx, *addr, god.WithDaemon(exe, args...))
+}
 
-	stdin, err := os.Open(os.DevNull)
-	if err != nil {
-		return err
+// stop stops the god daemon, if it is running, and waits for it to exit.
+func stop(ctx context.Context, c *god.Client) {
+	pid, _ := god.ReadPID(*addr)
+	if err := c.Stop(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, "god daemon is not running")
+		return
 	}
-	stdout, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
-	if err != nil {
-		return err
+	for i := 0; i < 200; i++ {
+		if _, err := os.Stat(god.PIDFile(*addr)); os.IsNotExist(err) {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
 	}
-	stderr, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	log.Fatalf("god daemon (pid %d) did not exit", pid)
+}
+
+// status reports whether the god daemon is running, and what it holds
+// and is doing; in JSON with -format=json.
+func status(ctx context.Context, c *god.Client) {
+	network, address := god.ResolveAddress(*addr)
+	st, err := c.Status(ctx)
 	if err != nil {
-		return err
+		fmt.Printf("god daemon is not running on %s %s\n", network, address)
+		os.Exit(1)
 	}
+	if *format == "json" {
+		data, err := json.MarshalIndent(st, "", "\t")
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s\n", data)
+		return
+	}
+	printStatus(os.Stdout, network, address, st)
+}
 
-	procAttr := os.ProcAttr{
-		Dir:   cwd,
-		Env:   syscall.Environ(),
-		Files: []*os.File{stdin, stdout, stderr},
+// printStatus prints st, the status of the daemon at address, for people.
+func printStatus(w io.Writer, network, address string, st *serialpb.DaemonStatus) {
+	const mb = 1 << 20
+	fmt.Fprintf(w, "god daemon (pid %d) is running on %s %s\n", st.Pid, network, address)
+	fmt.Fprintf(w, "version %s (%s), up %v, heap %.1f MB in use of %.1f MB\n",
+		st.Version, st.GoVersion, time.Duration(st.Uptime).Round(time.Second),
+		float64(st.HeapAlloc)/mb, float64(st.HeapSys)/mb)
+	if st.Coalesced > 0 {
+		fmt.Fprintf(w, "%d requests answered by an identical request in progress\n", st.Coalesced)
 	}
-	proc, err := os.StartProcess(path, args, &procAttr)
-	if err != nil {
-		return err
+
+	for _, ws := range st.Workspaces {
+		fmt.Fprintf(w, "\nworkspace GOROOT=%s GOPATH=%s GOOS=%s GOARCH=%s cgo=%t tags=%s\n",
+			ws.GOROOT, ws.GOPATH, ws.GOOS, ws.GOARCH, ws.CgoEnabled, strings.Join(ws.BuildTags, ","))
+		indexing := ""
+		if ws.Indexing {
+			indexing = ", indexing"
+		}
+		fmt.Fprintf(w, "\tindex: %d files%s; %s\n", ws.IndexedFiles, indexing, counters(ws.Index))
+		fmt.Fprintf(w, "\tresults: %s\n", counters(ws.ResultCache))
+		fmt.Fprintf(w, "\tprograms: %s; %d reloads type-checked %d packages again\n",
+			counters(ws.ProgramCache), ws.Reloads, ws.Rebuilt)
+		fmt.Fprintf(w, "\tpointer analyses: %s\n", counters(ws.AnalysisCache))
+		for _, prog := range ws.Programs {
+			if prog.Loading {
+				fmt.Fprintf(w, "\t\t(loading)\n")
+				continue
+			}
+			var built []string
+			if prog.SSA {
+				built = append(built, "SSA")
+			}
+			if prog.PointerAnalysis {
+				built = append(built, "pointer analysis")
+			}
+			fmt.Fprintf(w, "\t\t%s: %d packages", strings.Join(prog.Scope, " "), prog.Packages)
+			if len(built) > 0 {
+				fmt.Fprintf(w, ", %s", strings.Join(built, ", "))
+			}
+			fmt.Fprintln(w)
+		}
+	}
+
+	if len(st.Calls) > 0 {
+		fmt.Fprintf(w, "\nrequests in progress:\n")
 	}
+	for _, call := range st.Calls {
+		fmt.Fprintf(w, "\t%v\t%s", time.Duration(call.Elapsed).Round(time.Millisecond), call.Method)
+		if call.Mode != "" {
+			fmt.Fprintf(w, " %s", call.Mode)
+		}
+		if call.Pos != "" {
+			fmt.Fprintf(w, " %s", call.Pos)
+		}
+		fmt.Fprintln(w)
+	}
+}
 
-	return proc.Release()
+// counters formats the counters of a cache.
+func counters(c serialpb.Counters) string {
+	return fmt.Sprintf("%d hits, %d misses", c.Hits, c.Misses)
 }
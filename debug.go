@@ -0,0 +1,53 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"net"
+	"net/http"
+	"net/http/pprof"
+
+	"golang.org/x/net/trace"
+)
+
+// debugHandler returns the handler of the debug HTTP server: the traces
+// of requests at /debug/requests, the event logs at /debug/events, and
+// the profiles of the daemon at /debug/pprof.  Like the traces, which
+// x/net/trace only shows to local clients, profiles are not served to
+// remote clients.
+func debugHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/requests", trace.Traces)
+	mux.HandleFunc("/debug/events", trace.Events)
+	mux.HandleFunc("/debug/pprof/", localOnly(pprof.Index))
+	mux.HandleFunc("/debug/pprof/cmdline", localOnly(pprof.Cmdline))
+	mux.HandleFunc("/debug/pprof/profile", localOnly(pprof.Profile))
+	mux.HandleFunc("/debug/pprof/symbol", localOnly(pprof.Symbol))
+	mux.HandleFunc("/debug/pprof/trace", localOnly(pprof.Trace))
+	return mux
+}
+
+// localOnly restricts h to the clients trace.AuthRequest allows.
+func localOnly(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if ok, _ := trace.AuthRequest(r); !ok {
+			http.Error(w, "not allowed", http.StatusUnauthorized)
+			return
+		}
+		h(w, r)
+	}
+}
+
+// serveDebug serves debugHandler on the TCP address addr until the
+// returned server is closed.
+func serveDebug(addr string) (*http.Server, net.Addr, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	srv := &http.Server{Handler: debugHandler()}
+	go srv.Serve(lis)
+	return srv, lis.Addr(), nil
+}
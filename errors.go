@@ -0,0 +1,115 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"go/token"
+
+	"github.com/zchee/god/internal/guru"
+	serialpb "github.com/zchee/god/serial"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"golang.org/x/net/context"
+	"golang.org/x/tools/cmd/guru/serial"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// detailsTypeURL is the type URL of the serial.ErrorDetails attached to
+// the errors of queries.
+const detailsTypeURL = "type.googleapis.com/serial.ErrorDetails"
+
+// errorCode returns the gRPC code of the error of a guru query.  The
+// errors of other queries are Unknown.
+func errorCode(err error) codes.Code {
+	switch err.(type) {
+	case *guru.PosError:
+		return codes.InvalidArgument
+	case *guru.NotFoundError:
+		return codes.NotFound
+	case *guru.LoadError, *guru.ScopeError:
+		return codes.FailedPrecondition
+	}
+	return codes.Unknown
+}
+
+// queryError returns the error of query, whose mode is mode, as a gRPC
+// error.  The errors that errorCode classifies carry ErrorDetails: the
+// position of the query, the packages that could not be loaded, and,
+// if the position does not suit mode, the modes that suit it.
+func queryError(query *guru.Query, mode string, err error) error {
+	switch err {
+	case context.Canceled:
+		return grpc.Errorf(codes.Canceled, "%v", err)
+	case context.DeadlineExceeded:
+		return grpc.Errorf(codes.DeadlineExceeded, "%v", err)
+	}
+	code := errorCode(err)
+	if code == codes.Unknown {
+		return err
+	}
+
+	details := &serialpb.ErrorDetails{Pos: query.Pos}
+	if err, ok := err.(*guru.LoadError); ok {
+		details.Packages = err.Packages
+	}
+	if code != codes.FailedPrecondition {
+		details.Modes = suggestModes(query, mode)
+	}
+	value, merr := details.Marshal()
+	if merr != nil {
+		return grpc.Errorf(code, "%v", err)
+	}
+	return status.ErrorProto(&spb.Status{
+		Code:    int32(code),
+		Message: err.Error(),
+		Details: []*any.Any{{TypeUrl: detailsTypeURL, Value: value}},
+	})
+}
+
+// suggestModes returns the modes other than mode that apply at the
+// position of query, as reported by the what query.
+func suggestModes(query *guru.Query, mode string) []string {
+	q := &guru.Query{
+		Pos:     query.Pos,
+		Build:   query.Build,
+		Context: query.Context,
+	}
+	var modes []string
+	q.Output = func(fset *token.FileSet, qr guru.QueryResult) {
+		what, ok := qr.Result(fset).(*serial.What)
+		if !ok {
+			return
+		}
+		for _, m := range what.Modes {
+			if m != mode {
+				modes = append(modes, m)
+			}
+		}
+	}
+	guru.What(q) // no suggestions if it fails
+	return modes
+}
+
+// ErrorDetails returns the details that the god daemon attached to err,
+// the error of a query, or nil if there are none.
+func ErrorDetails(err error) *serialpb.ErrorDetails {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	for _, a := range st.Proto().GetDetails() {
+		if a.TypeUrl != detailsTypeURL {
+			continue
+		}
+		details := new(serialpb.ErrorDetails)
+		if err := details.Unmarshal(a.Value); err == nil {
+			return details
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	serialpb "github.com/zchee/god/serial"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestQueryErrors(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	gopath := filepath.Dir(filepath.Dir(filepath.Dir(filename)))
+	s.Addr = filepath.Join(gopath, "god.sock")
+	broken := writeBroken(t, gopath)
+
+	c, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+	defer func() {
+		s.Stop()
+		<-errc
+	}()
+	ctx := context.Background()
+
+	// No identifier: the position does not suit definition, but what
+	// suggests other modes.
+	loc := testLocation(filename, "var a", "")
+	_, err := c.GetDefinition(ctx, loc)
+	details := ErrorDetails(err)
+	if grpc.Code(err) != codes.InvalidArgument || details == nil || details.Pos != loc.Pos {
+		t.Fatalf("GetDefinition of a keyword: got error %v with details %+v, want InvalidArgument at %s", err, details, loc.Pos)
+	}
+	if len(details.Modes) == 0 {
+		t.Errorf("GetDefinition of a keyword: got no suggested modes")
+	}
+	for _, mode := range details.Modes {
+		if mode == "definition" {
+			t.Errorf("GetDefinition of a keyword: got suggested modes %v, want other modes than definition", details.Modes)
+		}
+	}
+
+	// Package p has no main and no tests.
+	loc = testLocation(filename, "f(y", "")
+	loc.Options = &serialpb.Options{Scope: "p"}
+	if _, err := c.GetCallers(ctx, loc); grpc.Code(err) != codes.FailedPrecondition {
+		t.Errorf("GetCallers without main: got error %v, want FailedPrecondition", err)
+	}
+
+	// Package broken has errors.
+	loc = &serialpb.Location{
+		Pos:     fmt.Sprintf("%s:#%d", broken, strings.Index(brokenSrc, "ch <-")),
+		Options: &serialpb.Options{Scope: "broken"},
+	}
+	_, err = c.GetPeers(ctx, loc)
+	details = ErrorDetails(err)
+	if grpc.Code(err) != codes.FailedPrecondition || details == nil ||
+		!reflect.DeepEqual(details.Packages, []string{"broken"}) || details.Modes != nil {
+		t.Errorf("GetPeers in a broken package: got error %v with details %+v, want FailedPrecondition of package broken", err, details)
+	}
+
+	// Other errors have no details.
+	if details := ErrorDetails(errors.New("error")); details != nil {
+		t.Errorf("got details %+v of a non-gRPC error", details)
+	}
+	if details := ErrorDetails(grpc.Errorf(codes.Internal, "error")); details != nil {
+		t.Errorf("got details %+v of an error without them", details)
+	}
+}
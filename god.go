@@ -3,3 +3,6 @@
 // license that can be found in the LICENSE file.
 
 package god
+
+// Version is the version of god, reported by the Status of the daemon.
+const Version = "devel"
@@ -0,0 +1,25 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package buildkey identifies build contexts, so that the programs,
+// workspaces and indexes of different contexts are never mixed up.
+package buildkey
+
+import (
+	"fmt"
+	"go/build"
+	"strings"
+)
+
+// Key returns a string that identifies the build context ctxt, or
+// build.Default if ctxt is nil.  Contexts with the same key find the
+// same packages with the same files.
+func Key(ctxt *build.Context) string {
+	if ctxt == nil {
+		ctxt = &build.Default
+	}
+	return fmt.Sprintf("GOROOT=%s GOPATH=%s GOOS=%s GOARCH=%s cgo=%t tags=%s compiler=%s",
+		ctxt.GOROOT, ctxt.GOPATH, ctxt.GOOS, ctxt.GOARCH, ctxt.CgoEnabled,
+		strings.Join(ctxt.BuildTags, ","), ctxt.Compiler)
+}
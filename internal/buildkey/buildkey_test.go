@@ -0,0 +1,29 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buildkey
+
+import (
+	"go/build"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	ctxt := build.Default
+	if Key(&ctxt) != Key(nil) {
+		t.Errorf("Key of a copy of build.Default = %q, want %q", Key(&ctxt), Key(nil))
+	}
+	for _, change := range []func(*build.Context){
+		func(c *build.Context) { c.GOPATH += "/other" },
+		func(c *build.Context) { c.GOOS = "plan9" },
+		func(c *build.Context) { c.CgoEnabled = !c.CgoEnabled },
+		func(c *build.Context) { c.BuildTags = []string{"tag"} },
+	} {
+		other := build.Default
+		change(&other)
+		if Key(&other) == Key(nil) {
+			t.Errorf("Key of a different context = %q, same as build.Default", Key(&other))
+		}
+	}
+}
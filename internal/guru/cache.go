@@ -0,0 +1,648 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package guru
+
+// This file defines the Cache of loaded programs shared by the queries
+// of a long-lived process such as the god daemon.
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"go/build"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zchee/god/internal/buildkey"
+
+	"golang.org/x/tools/go/buildutil"
+	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/go/pointer"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
+)
+
+// maxCacheEntries is the number of loaded programs a Cache retains
+// before it evicts the least recently used one.
+const maxCacheEntries = 16
+
+// A Cache holds loaded programs, their SSA form and their pointer
+// analysis across queries.
+//
+// Programs are keyed by the loader configuration (analysis scope or
+// query package, and build context) and are reused until one of their
+// source files or package directories changes on disk.  Only the
+// changed packages and the packages that depend on them are then
+// type-checked again.
+//
+// A Cache is safe for concurrent use by multiple queries.
+type Cache struct {
+	// Watch, if non-nil, is called with the package directories of
+	// each newly loaded program, so that the caller can report changes
+	// to them through Invalidate.
+	Watch func(dirs []string)
+
+	// Loaded, if non-nil, is called with the packages of each newly
+	// loaded or reloaded program that were type-checked including
+	// their function bodies, unless some files were not read from disk.
+	Loaded func(fset *token.FileSet, pkgs []*loader.PackageInfo)
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	stats   CacheStats
+}
+
+// CacheStats records the work done by a Cache.
+type CacheStats struct {
+	Hits    int // queries answered from a cached program
+	Loads   int // programs loaded from scratch
+	Reloads int // programs updated by type-checking only changed packages
+	Rebuilt int // packages type-checked again by Reloads
+
+	Analyses     int // pointer analyses run
+	AnalysisHits int // queries answered from a cached pointer analysis
+}
+
+// NewCache returns a new empty Cache.
+func NewCache() *Cache {
+	return &Cache{
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// Stats returns the statistics of c.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// A ProgramInfo describes a program held by a Cache.
+type ProgramInfo struct {
+	Scope           []string // initial packages, in sorted order
+	Packages        int      // number of packages, including dependencies
+	Loading         bool     // the program is being loaded; the other fields are zero
+	SSA             bool     // its SSA form was built
+	PointerAnalysis bool     // its pointer analysis was run
+	Used            time.Time
+}
+
+// Loading reports whether a program is being loaded or reloaded.
+func (c *Cache) Loading() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.entries {
+		select {
+		case <-e.ready:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Programs describes the programs held by c, most recently used first.
+func (c *Cache) Programs() []ProgramInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var progs []ProgramInfo
+	for _, e := range c.entries {
+		info := ProgramInfo{Used: e.used}
+		select {
+		case <-e.ready:
+		default:
+			info.Loading = true
+			progs = append(progs, info)
+			continue
+		}
+		if e.lprog == nil {
+			continue // failed
+		}
+		for path := range e.conf.ImportPkgs {
+			info.Scope = append(info.Scope, path)
+		}
+		for _, cp := range e.conf.CreatePkgs {
+			for _, f := range cp.Filenames {
+				info.Scope = append(info.Scope, f)
+			}
+		}
+		sort.Strings(info.Scope)
+		info.Packages = len(e.lprog.AllPackages)
+		info.SSA = e.created != nil
+		info.PointerAnalysis = e.analyzed
+		progs = append(progs, info)
+	}
+	sort.Sort(byUsed(progs))
+	return progs
+}
+
+type byUsed []ProgramInfo
+
+func (s byUsed) Len() int           { return len(s) }
+func (s byUsed) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byUsed) Less(i, j int) bool { return s[i].Used.After(s[j].Used) }
+
+// A cacheEntry is a loaded program and the versions of the files it
+// was loaded from.
+type cacheEntry struct {
+	ready chan struct{} // closed when loading is complete
+	conf  loader.Config // configuration lprog was loaded with
+	lprog *loader.Program
+	err   error
+
+	files map[string]fileVersion // source file -> version at load time
+	dirs  map[string]string      // package directory -> its Go files at load time
+
+	used    time.Time       // guarded by Cache.mu
+	invalid bool            // lprog must be loaded from scratch; guarded by Cache.mu
+	dirty   map[string]bool // import paths reported changed; guarded by Cache.mu
+
+	ssaOnce sync.Once
+	prog    *ssa.Program // SSA form of lprog, created on demand (GlobalDebug)
+	created *ssa.Program // prog, once created; guarded by Cache.mu
+
+	mainsOnce sync.Once
+	mains     []*ssa.Package // pointer analysis roots of prog
+
+	ptaOnce  sync.Once
+	pta      *pointer.Result // pointer analysis of mains, answering every query
+	ptaErr   error           // internal error of the pointer analysis
+	analyzed bool            // pta was computed; guarded by Cache.mu
+}
+
+// A fileVersion identifies the content of a file: its size and
+// modification time on disk, or the hash of the content supplied by the
+// build context instead, e.g. an unsaved editor buffer.
+type fileVersion struct {
+	size    int64
+	modTime time.Time
+	hash    [sha1.Size]byte
+}
+
+// virtual reports whether v is the version of content that was not
+// read from disk.
+func (v fileVersion) virtual() bool { return v.hash != [sha1.Size]byte{} }
+
+func statVersion(ctxt *build.Context, name string) fileVersion {
+	if ctxt.OpenFile != nil {
+		rc, err := ctxt.OpenFile(name)
+		if err != nil {
+			return fileVersion{size: -1} // missing
+		}
+		defer rc.Close()
+		if _, ok := rc.(*os.File); !ok {
+			content, err := ioutil.ReadAll(rc)
+			if err != nil {
+				return fileVersion{size: -1}
+			}
+			return fileVersion{size: int64(len(content)), hash: sha1.Sum(content)}
+		}
+	}
+	fi, err := os.Stat(name)
+	if err != nil {
+		return fileVersion{size: -1} // missing
+	}
+	return fileVersion{size: fi.Size(), modTime: fi.ModTime()}
+}
+
+// goFiles returns the names of the Go files in dir, which change only
+// when a file is added, removed or renamed.
+func goFiles(ctxt *build.Context, dir string) string {
+	fis, err := buildutil.ReadDir(ctxt, dir)
+	if err != nil {
+		return "" // missing
+	}
+	var names []string
+	for _, fi := range fis {
+		name := fi.Name()
+		if strings.HasSuffix(name, ".go") && !strings.HasPrefix(name, ".") && !strings.HasPrefix(name, "_") {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, " ")
+}
+
+// changes returns the source files of e whose content in ctxt differs
+// from the one they were loaded from.  ok is false if a package
+// directory gained or lost files, so that the program must be loaded
+// from scratch.
+func (e *cacheEntry) changes(ctxt *build.Context) (files map[string]bool, ok bool) {
+	for dir, names := range e.dirs {
+		if goFiles(ctxt, dir) != names {
+			return nil, false
+		}
+	}
+	for name, v := range e.files {
+		if statVersion(ctxt, name) != v {
+			if files == nil {
+				files = make(map[string]bool)
+			}
+			files[name] = true
+		}
+	}
+	return files, true
+}
+
+// program returns the program for lconf, loading it with load unless
+// an up-to-date program for the same configuration is cached.
+// bodies describes the packages whose function bodies lconf type-checks,
+// which is not otherwise captured by the configuration.  The versions
+// of the files of the program are recorded in versions.
+//
+// Queries whose build contexts differ only in the content of some files,
+// e.g. because of overlays, share a cache entry, which is updated to the
+// content seen by each query.
+//
+// If ctx is cancelled while the program is updated, the out-of-date
+// program stays cached for later queries.
+func (c *Cache) program(ctx context.Context, lconf *loader.Config, bodies string, load func(*loader.Config) (*loader.Program, error), versions *Versions) (*loader.Program, error) {
+	key := configKey(lconf, bodies)
+	ctxt := lconf.Build
+	if ctxt == nil {
+		ctxt = &build.Default
+	}
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	var base *cacheEntry // out-of-date entry to update
+	var changed, dirty map[string]bool
+	loading := false
+	if ok {
+		select {
+		case <-e.ready:
+			if e.err != nil || e.invalid {
+				ok = false
+				break
+			}
+			var same bool
+			changed, same = e.changes(ctxt)
+			if !same {
+				ok = false
+			} else if changed != nil || e.dirty != nil {
+				ok = false
+				base, dirty = e, e.dirty
+			}
+		default:
+			loading = true // wait below
+		}
+	}
+	if !ok {
+		e = &cacheEntry{ready: make(chan struct{}), used: time.Now()}
+		c.entries[key] = e
+		c.evictLocked()
+		c.mu.Unlock()
+
+		old := base
+		var rebuilt int
+		if base != nil {
+			e.conf = base.conf
+			e.conf.Build = lconf.Build
+			start := time.Now()
+			e.lprog, rebuilt, e.err = reload(ctx, &e.conf, base.lprog, changed, dirty)
+			if e.err != errReload {
+				tracef(ctx, "reload: type-checked %d packages again in %v", rebuilt, time.Since(start))
+			}
+		}
+		if base == nil || e.err == errReload {
+			base = nil
+			e.conf = *lconf // before load adjusts it
+			e.lprog, e.err = load(lconf)
+		}
+		virtual := false
+		if e.err == nil {
+			e.files, e.dirs, virtual = programVersions(ctxt, e.lprog)
+			if c.Watch != nil {
+				c.Watch(packageDirs(e.lprog))
+			}
+			if c.Loaded != nil && !virtual {
+				c.Loaded(e.lprog.Fset, checkedPackages(&e.conf, e.lprog))
+			}
+		}
+		close(e.ready)
+
+		c.mu.Lock()
+		switch {
+		case e.err != nil:
+			if c.entries[key] == e {
+				delete(c.entries, key) // don't cache failures
+				if old != nil {
+					old.invalid = old.invalid || e.invalid
+					c.entries[key] = old
+				}
+			}
+		case base != nil:
+			c.stats.Reloads++
+			c.stats.Rebuilt += rebuilt
+		default:
+			c.stats.Loads++
+		}
+		c.mu.Unlock()
+		versions.addEntry(ctxt, e)
+		return e.lprog, e.err
+	}
+	e.used = time.Now()
+	c.stats.Hits++
+	c.mu.Unlock()
+	tracef(ctx, "load: program cache hit")
+
+	<-e.ready
+	if loading && (e.err == context.Canceled || e.err == context.DeadlineExceeded) && ctx.Err() == nil {
+		// The program was loaded for another query, which was cancelled.
+		return c.program(ctx, lconf, bodies, load, versions)
+	}
+	if loading && e.err == nil {
+		// The program was loaded for another query,
+		// which may have seen different file contents.
+		if changed, same := e.changes(ctxt); !same || changed != nil {
+			return c.program(ctx, lconf, bodies, load, versions)
+		}
+	}
+	versions.addEntry(ctxt, e)
+	return e.lprog, e.err
+}
+
+// evictLocked discards the least recently used entries in excess of
+// maxCacheEntries.  c.mu must be held.
+func (c *Cache) evictLocked() {
+	for len(c.entries) > maxCacheEntries {
+		var oldest string
+		var t time.Time
+		for key, e := range c.entries {
+			if oldest == "" || e.used.Before(t) {
+				oldest, t = key, e.used
+			}
+		}
+		delete(c.entries, oldest)
+	}
+}
+
+// Invalidate marks the packages with the specified import paths as
+// changed in every cached program that contains them.  The packages of
+// the program that import them are type-checked again along with them.
+func (c *Cache) Invalidate(paths []string) {
+	changed := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		changed[path] = true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.entries {
+		select {
+		case <-e.ready:
+		default:
+			e.invalid = true // still loading; may have read the old files
+			continue
+		}
+		if e.lprog == nil {
+			continue
+		}
+		for pkg := range e.lprog.AllPackages {
+			path := strings.TrimSuffix(pkg.Path(), "_test")
+			if changed[path] {
+				if e.dirty == nil {
+					e.dirty = make(map[string]bool)
+				}
+				e.dirty[path] = true
+			}
+		}
+	}
+}
+
+// lookup returns the entry that holds lprog.
+func (c *Cache) lookup(lprog *loader.Program) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.entries {
+		if e.lprog == lprog {
+			return e
+		}
+	}
+	return nil
+}
+
+// ssaProgram returns the SSA form of lprog, which must have been
+// returned by c.program.  The program is created once, in GlobalDebug
+// mode so that it serves every query.
+func (c *Cache) ssaProgram(lprog *loader.Program) *ssa.Program {
+	e := c.lookup(lprog)
+	if e == nil {
+		// evicted meanwhile; don't cache
+		return ssautil.CreateProgram(lprog, ssa.GlobalDebug)
+	}
+	e.ssaOnce.Do(func() {
+		e.prog = ssautil.CreateProgram(lprog, ssa.GlobalDebug)
+
+		c.mu.Lock()
+		e.created = e.prog
+		c.mu.Unlock()
+	})
+	return e.prog
+}
+
+// discardSSA marks the program whose SSA form is prog to be loaded from
+// scratch by the next query, as building prog failed part way.
+func (c *Cache) discardSSA(prog *ssa.Program) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.entries {
+		if e.created == prog {
+			e.invalid = true
+		}
+	}
+}
+
+// ptaMains returns the pointer analysis roots of prog, the SSA form of
+// lprog.  Test main packages are created only once per program since
+// creating them modifies prog.
+func (c *Cache) ptaMains(prog *ssa.Program, lprog *loader.Program) []*ssa.Package {
+	e := c.lookup(lprog)
+	if e == nil || e.prog != prog {
+		return analysisMains(prog, lprog)
+	}
+	e.mainsOnce.Do(func() {
+		e.mains = analysisMains(prog, lprog)
+	})
+	return e.mains
+}
+
+// pointerAnalysis returns the cached pointer analysis of the program
+// of conf.Mains, running it on first use, or nil and no error if conf
+// cannot be answered from the cache.  The analysis is run once per program, with
+// a call graph and queries for every pointer-like value, so that it
+// answers the queries of any conf with the same roots.  Its internal
+// error, if any, is cached as well.
+func (c *Cache) pointerAnalysis(conf *pointer.Config) (*pointer.Result, error) {
+	if conf.Log != nil || conf.Reflection || len(conf.Mains) == 0 {
+		return nil, nil
+	}
+	prog := conf.Mains[0].Prog
+
+	c.mu.Lock()
+	var e *cacheEntry
+	for _, e2 := range c.entries {
+		if e2.created == prog {
+			e = e2
+			break
+		}
+	}
+	c.mu.Unlock()
+	if e == nil || !sameMains(c.ptaMains(prog, e.lprog), conf.Mains) {
+		return nil, nil
+	}
+
+	hit := true
+	e.ptaOnce.Do(func() {
+		hit = false
+		all := &pointer.Config{
+			Mains:          e.mains,
+			BuildCallGraph: true,
+		}
+		addAllQueries(all, prog)
+		e.pta, e.ptaErr = ptrAnalysis(all)
+		if e.ptaErr == nil {
+			e.pta.CallGraph.DeleteSyntheticNodes()
+		}
+
+		c.mu.Lock()
+		c.stats.Analyses++
+		e.analyzed = true
+		c.mu.Unlock()
+	})
+	if hit {
+		c.mu.Lock()
+		c.stats.AnalysisHits++
+		c.mu.Unlock()
+	}
+	return e.pta, e.ptaErr
+}
+
+func sameMains(x, y []*ssa.Package) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// addAllQueries adds to conf a query for every pointer-like value of
+// prog, and an indirect query for every address of one.
+func addAllQueries(conf *pointer.Config, prog *ssa.Program) {
+	add := func(v ssa.Value) {
+		if pointer.CanPoint(v.Type()) {
+			conf.AddQuery(v)
+		}
+		if ptr, ok := v.Type().Underlying().(*types.Pointer); ok && pointer.CanPoint(ptr.Elem()) {
+			conf.AddIndirectQuery(v)
+		}
+	}
+	for fn := range ssautil.AllFunctions(prog) {
+		for _, v := range fn.Params {
+			add(v)
+		}
+		for _, v := range fn.FreeVars {
+			add(v)
+		}
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				if v, ok := instr.(ssa.Value); ok {
+					add(v)
+				}
+			}
+		}
+	}
+	for _, pkg := range prog.AllPackages() {
+		for _, mem := range pkg.Members {
+			if g, ok := mem.(*ssa.Global); ok {
+				add(g)
+			}
+		}
+	}
+}
+
+// programVersions returns the versions in ctxt of every source file of
+// lprog, and the Go files of the directories that contain them so that
+// added and removed files are noticed too.  virtual reports whether the
+// content of some file was not read from disk.
+func programVersions(ctxt *build.Context, lprog *loader.Program) (files map[string]fileVersion, dirs map[string]string, virtual bool) {
+	files = make(map[string]fileVersion)
+	dirs = make(map[string]string)
+	for _, info := range lprog.AllPackages {
+		for _, f := range info.Files {
+			name := lprog.Fset.File(f.Pos()).Name()
+			v := statVersion(ctxt, name)
+			files[name] = v
+			virtual = virtual || v.virtual()
+			if dir := filepath.Dir(name); dirs[dir] == "" {
+				dirs[dir] = goFiles(ctxt, dir)
+			}
+		}
+	}
+	return files, dirs, virtual
+}
+
+// packageDirs returns the directories of the packages of lprog.
+func packageDirs(lprog *loader.Program) []string {
+	seen := make(map[string]bool)
+	var dirs []string
+	for _, info := range lprog.AllPackages {
+		for _, f := range info.Files {
+			dir := filepath.Dir(lprog.Fset.File(f.Pos()).Name())
+			if !seen[dir] {
+				seen[dir] = true
+				dirs = append(dirs, dir)
+			}
+		}
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// checkedPackages returns the packages of lprog whose function bodies
+// were type-checked according to conf.
+func checkedPackages(conf *loader.Config, lprog *loader.Program) []*loader.PackageInfo {
+	var pkgs []*loader.PackageInfo
+	for _, info := range lprog.AllPackages {
+		if f := conf.TypeCheckFuncBodies; f == nil || f(info.Pkg.Path()) {
+			pkgs = append(pkgs, info)
+		}
+	}
+	return pkgs
+}
+
+// configKey returns a string that identifies the program loaded by lconf.
+func configKey(lconf *loader.Config, bodies string) string {
+	var buf bytes.Buffer
+	buf.WriteString(buildkey.Key(lconf.Build))
+	fmt.Fprintf(&buf, "|allowErrors=%t|bodies=%s", lconf.AllowErrors, bodies)
+
+	var imports []string
+	for path, tests := range lconf.ImportPkgs {
+		if tests {
+			path += " (with tests)"
+		}
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	fmt.Fprintf(&buf, "|import=%s", strings.Join(imports, ","))
+
+	for _, cp := range lconf.CreatePkgs {
+		fmt.Fprintf(&buf, "|create=%s:%s", cp.Path, strings.Join(cp.Filenames, ","))
+	}
+	return buf.String()
+}
+
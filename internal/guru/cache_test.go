@@ -0,0 +1,352 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package guru
+
+import (
+	"context"
+	"fmt"
+	"go/build"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/cmd/guru/serial"
+	"golang.org/x/tools/go/buildutil"
+	"golang.org/x/tools/go/loader"
+)
+
+func TestCache(t *testing.T) {
+	gopath := newTestGOPATH(t)
+	defer gopath.remove()
+	gopath.write("p/p.go", "package p\n\nvar X int\n")
+	gopath.write("r/r.go", "package r\n\nvar Z int\n")
+	const qsrc = "package q\n\nimport (\n\t\"p\"\n\t_ \"r\"\n)\n\nvar y = p.X\n"
+	qfile := gopath.write("q/q.go", qsrc)
+
+	buildContext := gopath.ctxt
+	cache := NewCache()
+
+	// describe runs a describe query on q.y and returns its type.
+	describe := func() string {
+		var typ string
+		q := &Query{
+			Pos:   fmt.Sprintf("%s:#%d", qfile, strings.Index(qsrc, "y =")),
+			Build: &buildContext,
+			Output: func(fset *token.FileSet, qr QueryResult) {
+				typ = qr.Result(fset).(*serial.Describe).Value.Type
+			},
+			Cache: cache,
+		}
+		if err := Describe(q); err != nil {
+			t.Fatal(err)
+		}
+		return typ
+	}
+
+	tests := []struct {
+		name  string
+		edit  func()
+		want  string // type of q.y
+		stats CacheStats
+	}{
+		{
+			name:  "first query",
+			edit:  func() {},
+			want:  "int",
+			stats: CacheStats{Loads: 1},
+		},
+		{
+			name:  "second query",
+			edit:  func() {},
+			want:  "int",
+			stats: CacheStats{Loads: 1, Hits: 1},
+		},
+		{
+			name:  "modify p",
+			edit:  func() { gopath.write("p/p.go", "package p\n\nvar X string\n") },
+			want:  "string",
+			stats: CacheStats{Loads: 1, Hits: 1, Reloads: 1, Rebuilt: 2}, // p and q
+		},
+		{
+			name:  "invalidate r",
+			edit:  func() { cache.Invalidate([]string{"r"}) },
+			want:  "string",
+			stats: CacheStats{Loads: 1, Hits: 1, Reloads: 2, Rebuilt: 4}, // r and q
+		},
+		{
+			name:  "add file to p",
+			edit:  func() { gopath.write("p/p2.go", "package p\n") },
+			want:  "string",
+			stats: CacheStats{Loads: 2, Hits: 1, Reloads: 2, Rebuilt: 4},
+		},
+		{
+			name: "import new package",
+			edit: func() {
+				gopath.write("s/s.go", "package s\n")
+				gopath.write("r/r.go", "package r\n\nimport _ \"s\"\n")
+			},
+			want:  "string",
+			stats: CacheStats{Loads: 3, Hits: 1, Reloads: 2, Rebuilt: 4},
+		},
+	}
+	for _, test := range tests {
+		test.edit()
+		if got := describe(); got != test.want {
+			t.Errorf("%s: got type %s, want %s", test.name, got, test.want)
+		}
+		if got := cache.Stats(); got != test.stats {
+			t.Errorf("%s: got stats %+v, want %+v", test.name, got, test.stats)
+		}
+	}
+	if n := len(cache.entries); n != 1 {
+		t.Errorf("got %d cache entries, want 1", n)
+	}
+}
+
+func TestCacheOverlay(t *testing.T) {
+	gopath := newTestGOPATH(t)
+	defer gopath.remove()
+
+	const src = "package p\n\nvar x = 1\n"
+	filename := gopath.write("p/p.go", src)
+
+	buildContext := gopath.ctxt
+	cache := NewCache()
+	var loaded int
+	cache.Loaded = func(*token.FileSet, []*loader.PackageInfo) { loaded++ }
+
+	// describe describes the identifier at offset in the content of
+	// p.go seen by a query with the specified overlay.
+	describe := func(overlay map[string][]byte, offset int) string {
+		var typ string
+		q := &Query{
+			Pos:   fmt.Sprintf("%s:#%d", filename, offset),
+			Build: &buildContext,
+			Output: func(fset *token.FileSet, qr QueryResult) {
+				typ = qr.Result(fset).(*serial.Describe).Value.Type
+			},
+			Cache: cache,
+		}
+		if overlay != nil {
+			q.Build = buildutil.OverlayContext(q.Build, overlay)
+		}
+		if err := Describe(q); err != nil {
+			t.Fatal(err)
+		}
+		return typ
+	}
+
+	const modified = "package p\n\nvar (\n\ty = \"\"\n\tx = y\n)\n"
+	overlay := map[string][]byte{filename: []byte(modified)}
+	for i := 0; i < 2; i++ {
+		if got := describe(nil, strings.Index(src, "x")); got != "int" {
+			t.Errorf("got type %s on disk, want int", got)
+		}
+		if got := describe(overlay, strings.Index(modified, "x")); got != "string" {
+			t.Errorf("got type %s in overlay, want string", got)
+		}
+	}
+	if n := len(cache.entries); n != 1 {
+		t.Errorf("got %d cache entries, want 1", n)
+	}
+	if loaded != 2 {
+		t.Errorf("Loaded called %d times, want 2 (programs with overlays are not reported)", loaded)
+	}
+}
+
+func TestCacheCancel(t *testing.T) {
+	gopath := newTestGOPATH(t)
+	defer gopath.remove()
+
+	const src = "package p\n\nvar x = 1\n"
+	filename := gopath.write("p/p.go", src)
+
+	buildContext := gopath.ctxt
+	cache := NewCache()
+
+	describe := func(ctx context.Context) error {
+		return Describe(&Query{
+			Pos:     fmt.Sprintf("%s:#%d", filename, strings.Index(src, "x")),
+			Build:   &buildContext,
+			Output:  func(*token.FileSet, QueryResult) {},
+			Cache:   cache,
+			Context: ctx,
+		})
+	}
+	if err := describe(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// A cancelled query fails, but keeps the cached program.
+	if err := ioutil.WriteFile(filename, []byte(src+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := describe(ctx); err != context.Canceled {
+		t.Errorf("cancelled query: got error %v, want %v", err, context.Canceled)
+	}
+	if err := describe(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cache.Stats(), (CacheStats{Loads: 1, Reloads: 1, Rebuilt: 1}); got != want {
+		t.Errorf("got stats %+v, want %+v", got, want)
+	}
+}
+
+func TestCachePointerAnalysis(t *testing.T) {
+	gopath := newTestGOPATH(t)
+	defer gopath.remove()
+
+	const src = `package main
+
+type I interface{ f() }
+
+type A struct{}
+
+func (A) f() {}
+
+type B struct{}
+
+func (B) f() {}
+
+var b bool
+
+func main() {
+	var i I = A{}
+	if b {
+		i = B{}
+	}
+	i.f()
+	p := new(int)
+	print(p)
+}
+`
+	filename := gopath.write("m/m.go", src)
+
+	buildContext := gopath.ctxt
+	cache := NewCache()
+
+	// run runs a query of the specified mode at the first occurrence
+	// of sel and returns its result.
+	run := func(mode, sel string) interface{} {
+		var result interface{}
+		q := &Query{
+			Pos:   fmt.Sprintf("%s:#%d", filename, strings.Index(src, sel)),
+			Build: &buildContext,
+			Scope: []string{"m"},
+			Output: func(fset *token.FileSet, qr QueryResult) {
+				result = qr.Result(fset)
+			},
+			Cache: cache,
+		}
+		if err := Run(mode, q); err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		return result
+	}
+
+	for i := 0; i < 2; i++ {
+		if callees := run("callees", "f()\n\tp").(*serial.Callees); len(callees.Callees) != 2 {
+			t.Errorf("got %d callees, want 2", len(callees.Callees))
+		}
+		if pts := run("pointsto", "p)").([]serial.PointsTo); len(pts) != 1 || len(pts[0].Labels) != 1 {
+			t.Errorf("got points-to %+v, want one label", pts)
+		}
+	}
+	if stats := cache.Stats(); stats.Analyses != 1 || stats.AnalysisHits != 3 {
+		t.Errorf("got %d pointer analyses and %d hits, want 1 and 3", stats.Analyses, stats.AnalysisHits)
+	}
+	if progs := cache.Programs(); len(progs) != 1 || !progs[0].SSA || !progs[0].PointerAnalysis ||
+		len(progs[0].Scope) != 1 || progs[0].Scope[0] != "m" {
+		t.Errorf("got programs %+v, want m with its SSA form and pointer analysis", progs)
+	}
+
+	// A change to the scope discards the analysis.
+	if err := ioutil.WriteFile(filename, []byte(src+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	run("callees", "f()\n\tp")
+	if n := cache.Stats().Analyses; n != 2 {
+		t.Errorf("got %d pointer analyses after modification, want 2", n)
+	}
+}
+
+// A testGOPATH is a temporary GOPATH for the tests of queries.
+type testGOPATH struct {
+	t    *testing.T
+	ctxt build.Context // build.Default with the GOPATH
+}
+
+// newTestGOPATH returns a new, empty testGOPATH.  The caller must call
+// its remove method.
+func newTestGOPATH(t *testing.T) *testGOPATH {
+	dir, err := ioutil.TempDir("", "god-guru")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &testGOPATH{t: t, ctxt: build.Default}
+	g.ctxt.GOPATH = dir
+	return g
+}
+
+// write writes content to the file name, relative to the src directory
+// of g, and returns the absolute name of the file.
+func (g *testGOPATH) write(name, content string) string {
+	name = filepath.Join(g.ctxt.GOPATH, "src", name)
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		g.t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(name, []byte(content), 0644); err != nil {
+		g.t.Fatal(err)
+	}
+	return name
+}
+
+// remove removes g.
+func (g *testGOPATH) remove() {
+	os.RemoveAll(g.ctxt.GOPATH)
+}
+
+// TestCacheInvalidateImporter checks that invalidating a package
+// type-checks again the packages that import it, directly or not, and
+// that the results of queries on them depend on it, so that a watcher
+// need only report the packages whose files changed.
+func TestCacheInvalidateImporter(t *testing.T) {
+	gopath := newTestGOPATH(t)
+	defer gopath.remove()
+	gopath.write("p/p.go", "package p\n\nvar X int\n")
+	gopath.write("m/m.go", "package m\n\nimport \"p\"\n\nvar Y = p.X\n")
+	const qsrc = "package q\n\nimport \"m\"\n\nvar z = m.Y\n"
+	qfile := gopath.write("q/q.go", qsrc)
+
+	buildContext := gopath.ctxt
+	cache := NewCache()
+	describe := func() *Versions {
+		q := &Query{
+			Pos:      fmt.Sprintf("%s:#%d", qfile, strings.Index(qsrc, "z =")),
+			Build:    &buildContext,
+			Output:   func(*token.FileSet, QueryResult) {},
+			Cache:    cache,
+			Versions: new(Versions),
+		}
+		if err := Describe(q); err != nil {
+			t.Fatal(err)
+		}
+		return q.Versions
+	}
+
+	if v := describe(); !v.DependsOn(map[string]bool{"p": true}) {
+		t.Error("the result of a query on q does not depend on p")
+	}
+	cache.Invalidate([]string{"p"})
+	describe()
+	if got, want := cache.Stats(), (CacheStats{Loads: 1, Reloads: 1, Rebuilt: 3}); got != want {
+		t.Errorf("got stats %+v, want %+v (p, m and q type-checked again)", got, want)
+	}
+}
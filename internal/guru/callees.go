@@ -15,7 +15,6 @@ import (
 	"golang.org/x/tools/go/loader"
 	"golang.org/x/tools/go/pointer"
 	"golang.org/x/tools/go/ssa"
-	"golang.org/x/tools/go/ssa/ssautil"
 )
 
 // Callees reports the possible Callees of the function call site
@@ -28,12 +27,12 @@ func Callees(q *Query) error {
 	}
 
 	// Load/parse/type-check the program.
-	lprog, err := loadWithSoftErrors(&lconf)
+	lprog, err := q.load(&lconf, "", loadWithSoftErrors)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, true) // needs exact pos
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, true) // needs exact pos
 	if err != nil {
 		return err
 	}
@@ -46,7 +45,7 @@ func Callees(q *Query) error {
 		}
 	}
 	if e == nil {
-		return fmt.Errorf("there is no function call here")
+		return posErrorf("there is no function call here")
 	}
 	// TODO(adonovan): issue an error if the call is "too far
 	// away" from the current selection, as this most likely is
@@ -54,7 +53,7 @@ func Callees(q *Query) error {
 
 	// Reject type conversions.
 	if qpos.Info.Types[e.Fun].IsType() {
-		return fmt.Errorf("this is a type conversion, not a function call")
+		return posErrorf("this is a type conversion, not a function call")
 	}
 
 	// Deal with obviously static calls before constructing SSA form.
@@ -65,7 +64,7 @@ func Callees(q *Query) error {
 		switch obj := qpos.Info.Uses[funexpr].(type) {
 		case *types.Builtin:
 			// Reject calls to built-ins.
-			return fmt.Errorf("this is a call to the built-in '%s' operator", obj.Name())
+			return posErrorf("this is a call to the built-in '%s' operator", obj.Name())
 		case *types.Func:
 			// This is a static function call
 			q.Output(lprog.Fset, &calleesTypesResult{
@@ -106,9 +105,9 @@ func Callees(q *Query) error {
 		}
 	}
 
-	prog := ssautil.CreateProgram(lprog, ssa.GlobalDebug)
+	prog := q.ssaProgram(lprog, ssa.GlobalDebug)
 
-	ptaConfig, err := setupPTA(prog, lprog, q.PTALog, q.Reflection)
+	ptaConfig, err := q.setupPTA(prog, lprog)
 	if err != nil {
 		return err
 	}
@@ -119,12 +118,14 @@ func Callees(q *Query) error {
 	}
 
 	// Defer SSA construction till after errors are reported.
-	prog.Build()
+	if err := q.buildSSA(prog); err != nil {
+		return err
+	}
 
 	// Ascertain calling function and call site.
 	callerFn := ssa.EnclosingFunction(pkg, qpos.Path)
 	if callerFn == nil {
-		return fmt.Errorf("no SSA function built for this location (dead code?)")
+		return notFoundErrorf("no SSA function built for this location (dead code?)")
 	}
 
 	// Find the call site.
@@ -133,7 +134,7 @@ func Callees(q *Query) error {
 		return err
 	}
 
-	funcs, err := findCallees(ptaConfig, site)
+	funcs, err := q.findCallees(ptaConfig, site)
 	if err != nil {
 		return err
 	}
@@ -149,12 +150,12 @@ func findCallSite(fn *ssa.Function, call *ast.CallExpr) (ssa.CallInstruction, er
 	instr, _ := fn.ValueForExpr(call)
 	callInstr, _ := instr.(ssa.CallInstruction)
 	if instr == nil {
-		return nil, fmt.Errorf("this call site is unreachable in this analysis")
+		return nil, notFoundErrorf("this call site is unreachable in this analysis")
 	}
 	return callInstr, nil
 }
 
-func findCallees(conf *pointer.Config, site ssa.CallInstruction) ([]*ssa.Function, error) {
+func (q *Query) findCallees(conf *pointer.Config, site ssa.CallInstruction) ([]*ssa.Function, error) {
 	// Avoid running the pointer analysis for static calls.
 	if callee := site.Common().StaticCallee(); callee != nil {
 		switch callee.String() {
@@ -169,13 +170,16 @@ func findCallees(conf *pointer.Config, site ssa.CallInstruction) ([]*ssa.Functio
 
 	// Dynamic call: use pointer analysis.
 	conf.BuildCallGraph = true
-	cg := ptrAnalysis(conf).CallGraph
-	cg.DeleteSyntheticNodes()
+	ptares, err := q.ptrAnalysis(conf)
+	if err != nil {
+		return nil, err
+	}
+	cg := ptares.CallGraph
 
 	// Find all call edges from the site.
 	n := cg.Nodes[site.Parent()]
 	if n == nil {
-		return nil, fmt.Errorf("this call site is unreachable in this analysis")
+		return nil, notFoundErrorf("this call site is unreachable in this analysis")
 	}
 	calleesMap := make(map[*ssa.Function]bool)
 	for _, edge := range n.Out {
@@ -27,19 +27,19 @@ func Callers(q *Query) error {
 	}
 
 	// Load/parse/type-check the program.
-	lprog, err := loadWithSoftErrors(&lconf)
+	lprog, err := q.load(&lconf, "", loadWithSoftErrors)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, false)
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, false)
 	if err != nil {
 		return err
 	}
 
-	prog := ssautil.CreateProgram(lprog, 0)
+	prog := q.ssaProgram(lprog, 0)
 
-	ptaConfig, err := setupPTA(prog, lprog, q.PTALog, q.Reflection)
+	ptaConfig, err := q.setupPTA(prog, lprog)
 	if err != nil {
 		return err
 	}
@@ -49,15 +49,17 @@ func Callers(q *Query) error {
 		return fmt.Errorf("no SSA package")
 	}
 	if !ssa.HasEnclosingFunction(pkg, qpos.Path) {
-		return fmt.Errorf("this position is not inside a function")
+		return posErrorf("this position is not inside a function")
 	}
 
 	// Defer SSA construction till after errors are reported.
-	prog.Build()
+	if err := q.buildSSA(prog); err != nil {
+		return err
+	}
 
 	target := ssa.EnclosingFunction(pkg, qpos.Path)
 	if target == nil {
-		return fmt.Errorf("no SSA function built for this location (dead code?)")
+		return notFoundErrorf("no SSA function built for this location (dead code?)")
 	}
 
 	// If the function is never address-taken, all calls are direct
@@ -69,10 +71,18 @@ func Callers(q *Query) error {
 		// (Pointer analysis may return fewer results than
 		// directCallsTo because it ignores dead code.)
 		ptaConfig.BuildCallGraph = true
-		cg = ptrAnalysis(ptaConfig).CallGraph
+		ptares, err := q.ptrAnalysis(ptaConfig)
+		if err != nil {
+			return err
+		}
+		cg = ptares.CallGraph
+	} else {
+		cg.DeleteSyntheticNodes()
+	}
+	var edges []*callgraph.Edge
+	if n := cg.Nodes[target]; n != nil {
+		edges = n.In
 	}
-	cg.DeleteSyntheticNodes()
-	edges := cg.CreateNode(target).In
 
 	// TODO(adonovan): sort + dedup calls to ensure test determinism.
 
@@ -13,7 +13,6 @@ import (
 	"golang.org/x/tools/go/callgraph/static"
 	"golang.org/x/tools/go/loader"
 	"golang.org/x/tools/go/ssa"
-	"golang.org/x/tools/go/ssa/ssautil"
 )
 
 // Callstack displays an arbitrary path from a root of the callgraph
@@ -27,27 +26,26 @@ import (
 // the analysis root.
 //
 func Callstack(q *Query) error {
-	fset := token.NewFileSet()
-	lconf := loader.Config{Fset: fset, Build: q.Build}
+	lconf := loader.Config{Build: q.Build}
 
 	if err := setPTAScope(&lconf, q.Scope); err != nil {
 		return err
 	}
 
 	// Load/parse/type-check the program.
-	lprog, err := loadWithSoftErrors(&lconf)
+	lprog, err := q.load(&lconf, "", loadWithSoftErrors)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, false)
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, false)
 	if err != nil {
 		return err
 	}
 
-	prog := ssautil.CreateProgram(lprog, 0)
+	prog := q.ssaProgram(lprog, 0)
 
-	ptaConfig, err := setupPTA(prog, lprog, q.PTALog, q.Reflection)
+	ptaConfig, err := q.setupPTA(prog, lprog)
 	if err != nil {
 		return err
 	}
@@ -58,15 +56,17 @@ func Callstack(q *Query) error {
 	}
 
 	if !ssa.HasEnclosingFunction(pkg, qpos.Path) {
-		return fmt.Errorf("this position is not inside a function")
+		return posErrorf("this position is not inside a function")
 	}
 
 	// Defer SSA construction till after errors are reported.
-	prog.Build()
+	if err := q.buildSSA(prog); err != nil {
+		return err
+	}
 
 	target := ssa.EnclosingFunction(pkg, qpos.Path)
 	if target == nil {
-		return fmt.Errorf("no SSA function built for this location (dead code?)")
+		return notFoundErrorf("no SSA function built for this location (dead code?)")
 	}
 
 	var callpath []*callgraph.Edge
@@ -88,15 +88,18 @@ func Callstack(q *Query) error {
 	// Run the pointer analysis and build a complete call graph.
 	if callpath == nil {
 		ptaConfig.BuildCallGraph = true
-		cg := ptrAnalysis(ptaConfig).CallGraph
-		cg.DeleteSyntheticNodes()
+		ptares, err := q.ptrAnalysis(ptaConfig)
+		if err != nil {
+			return err
+		}
+		cg := ptares.CallGraph
 		callpath = callgraph.PathSearch(cg.Root, isEnd)
 		if callpath != nil {
 			callpath = callpath[1:] // remove synthetic edge from <root>
 		}
 	}
 
-	q.Output(fset, &callstackResult{
+	q.Output(lprog.Fset, &callstackResult{
 		qpos:     qpos,
 		target:   target,
 		callpath: callpath,
@@ -30,10 +30,11 @@ func Definition(q *Query) error {
 		if err != nil {
 			return err
 		}
+		q.Versions.addFile(q.Build, qpos.Fset.File(qpos.Start).Name())
 
 		id, _ := qpos.Path[0].(*ast.Ident)
 		if id == nil {
-			return fmt.Errorf("no identifier here")
+			return posErrorf("no identifier here")
 		}
 
 		// Did the parser resolve it to a local object?
@@ -52,6 +53,7 @@ func Definition(q *Query) error {
 			if err != nil {
 				return err
 			}
+			q.Versions.addFile(q.Build, qpos.Fset.File(pos).Name())
 			q.Output(qpos.Fset, &definitionResult{
 				pos:   pos,
 				descr: fmt.Sprintf("%s %s.%s", tok, pkg, id.Name),
@@ -66,24 +68,25 @@ func Definition(q *Query) error {
 	lconf := loader.Config{Build: q.Build}
 	allowErrors(&lconf)
 
-	if _, err := importQueryPackage(q.Pos, &lconf); err != nil {
+	qpkg, err := importQueryPackage(q.Pos, &lconf)
+	if err != nil {
 		return err
 	}
 
 	// Load/parse/type-check the program.
-	lprog, err := lconf.Load()
+	lprog, err := q.load(&lconf, qpkg, (*loader.Config).Load)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, false)
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, false)
 	if err != nil {
 		return err
 	}
 
 	id, _ := qpos.Path[0].(*ast.Ident)
 	if id == nil {
-		return fmt.Errorf("no identifier here")
+		return posErrorf("no identifier here")
 	}
 
 	// Look up the declaration of this identifier.
@@ -97,12 +100,12 @@ func Definition(q *Query) error {
 			// Happens for y in "switch y := x.(type)",
 			// and the package declaration,
 			// but I think that's all.
-			return fmt.Errorf("no object for identifier")
+			return notFoundErrorf("no object for identifier")
 		}
 	}
 
 	if !obj.Pos().IsValid() {
-		return fmt.Errorf("%s is built in", obj.Name())
+		return posErrorf("%s is built in", obj.Name())
 	}
 
 	q.Output(lprog.Fset, &definitionResult{
@@ -185,7 +188,7 @@ func findPackageMember(ctxt *build.Context, fset *token.FileSet, srcdir, pkg, me
 		}
 	}
 
-	return 0, token.NoPos, fmt.Errorf("couldn't find declaration of %s in %q", member, pkg)
+	return 0, token.NoPos, notFoundErrorf("couldn't find declaration of %s in %q", member, pkg)
 }
 
 type definitionResult struct {
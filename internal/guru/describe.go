@@ -31,17 +31,18 @@ func Describe(q *Query) error {
 	lconf := loader.Config{Build: q.Build}
 	allowErrors(&lconf)
 
-	if _, err := importQueryPackage(q.Pos, &lconf); err != nil {
+	qpkg, err := importQueryPackage(q.Pos, &lconf)
+	if err != nil {
 		return err
 	}
 
 	// Load/parse/type-check the program.
-	lprog, err := lconf.Load()
+	lprog, err := q.load(&lconf, qpkg, (*loader.Config).Load)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, true) // (need exact pos)
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, true) // (need exact pos)
 	if err != nil {
 		return err
 	}
@@ -316,7 +317,7 @@ func describeValue(qpos *QueryPos, path []ast.Node) (*describeValueResult, error
 	switch n := path[0].(type) {
 	case *ast.ValueSpec:
 		// ambiguous ValueSpec containing multiple names
-		return nil, fmt.Errorf("multiple value specification")
+		return nil, posErrorf("multiple value specification")
 	case *ast.Ident:
 		obj = qpos.Info.ObjectOf(n)
 		expr = n
@@ -580,7 +581,7 @@ func describePackage(qpos *QueryPos, path []ast.Node) (*describePackageResult, e
 		}
 		pkgname, _ := obj.(*types.PkgName)
 		if pkgname == nil {
-			return nil, fmt.Errorf("can't import package %s", n.Path.Value)
+			return nil, scopeErrorf("can't import package %s", n.Path.Value)
 		}
 		pkg = pkgname.Imported()
 		description = fmt.Sprintf("import of package %q", pkg.Path())
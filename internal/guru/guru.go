@@ -11,6 +11,7 @@ package guru
 //   (&T{}, var t T, new(T), new(struct{array [3]T}), etc.
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"go/ast"
@@ -19,15 +20,22 @@ import (
 	"go/token"
 	"go/types"
 	"io"
-	"log"
 	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"golang.org/x/net/trace"
 	"golang.org/x/tools/go/ast/astutil"
 	"golang.org/x/tools/go/buildutil"
 	"golang.org/x/tools/go/loader"
 	"golang.org/x/tools/go/pointer"
 	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
 )
 
 type printfFunc func(pos interface{}, format string, args ...interface{})
@@ -78,8 +86,36 @@ type Query struct {
 	PTALog     io.Writer // (optional) pointer-analysis log file
 	Reflection bool      // model reflection soundly (currently slow).
 
-	// result-printing function
+	// result-printing function; referrers queries may call it
+	// concurrently, on goroutines of the loader, and return its panics
+	// there as a *PanicError
 	Output func(*token.FileSet, QueryResult)
+
+	// (optional) programs shared with previous queries
+	Cache *Cache
+
+	// (optional) versions of the files the query depends on,
+	// recorded as it reads them
+	Versions *Versions
+
+	// (optional) context whose cancellation stops the query
+	Context context.Context
+}
+
+// context returns the context of q, which is never nil.
+func (q *Query) context() context.Context {
+	if q.Context == nil {
+		return context.Background()
+	}
+	return q.Context
+}
+
+// tracef records an event in the trace of ctx, if any, e.g. the trace
+// of the request that runs a query.
+func tracef(ctx context.Context, format string, args ...interface{}) {
+	if tr, ok := trace.FromContext(ctx); ok {
+		tr.LazyPrintf(format, args...)
+	}
 }
 
 // Run runs an guru query and populates its Fset and Result.
@@ -110,14 +146,14 @@ func Run(mode string, q *Query) error {
 	case "what":
 		return What(q)
 	default:
-		return fmt.Errorf("invalid mode: %q", mode)
+		return posErrorf("invalid mode: %q", mode)
 	}
 }
 
 func setPTAScope(lconf *loader.Config, scope []string) error {
 	pkgs := buildutil.ExpandPatterns(lconf.Build, scope)
 	if len(pkgs) == 0 {
-		return fmt.Errorf("no packages specified for pointer analysis scope")
+		return scopeErrorf("no packages specified for pointer analysis scope")
 	}
 	// The value of each entry in pkgs is true,
 	// giving ImportWithTests (not Import) semantics.
@@ -125,9 +161,177 @@ func setPTAScope(lconf *loader.Config, scope []string) error {
 	return nil
 }
 
+// load loads the program specified by lconf by calling load, or
+// returns the equivalent program from q.Cache, if any.
+// bodies names the packages whose function bodies lconf type-checks.
+// Loading stops early, with the error of q's context, if the query is
+// cancelled.
+func (q *Query) load(lconf *loader.Config, bodies string, load func(*loader.Config) (*loader.Program, error)) (*loader.Program, error) {
+	ctx := q.context()
+	cancelableLoad := func(lconf *loader.Config) (*loader.Program, error) {
+		cancelable(ctx, lconf)
+		checked := countTypeChecked(lconf)
+		start := time.Now()
+		lprog, err := load(lconf)
+		tracef(ctx, "load: type-checked %d packages in %v", atomic.LoadInt32(checked), time.Since(start))
+		if ctx.Err() != nil {
+			return nil, ctx.Err() // lprog may be incomplete
+		}
+		return lprog, err
+	}
+	if q.Cache == nil {
+		ctxt := lconf.Build
+		if ctxt == nil {
+			ctxt = &build.Default
+		}
+		lprog, err := cancelableLoad(lconf)
+		if err == nil {
+			q.Versions.addProgram(ctxt, lprog)
+		}
+		return lprog, err
+	}
+	return q.Cache.program(ctx, lconf, bodies, cancelableLoad, q.Versions)
+}
+
+// cancelable installs hooks in lconf so that, once ctx is done, the
+// loader neither finds any more packages nor type-checks any more
+// function bodies.
+func cancelable(ctx context.Context, lconf *loader.Config) {
+	find := lconf.FindPackage
+	if find == nil {
+		find = (*build.Context).Import
+	}
+	lconf.FindPackage = func(ctxt *build.Context, path, dir string, mode build.ImportMode) (*build.Package, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return find(ctxt, path, dir, mode)
+	}
+	bodies := lconf.TypeCheckFuncBodies
+	lconf.TypeCheckFuncBodies = func(path string) bool {
+		return ctx.Err() == nil && (bodies == nil || bodies(path))
+	}
+}
+
+// countTypeChecked installs a hook in lconf that counts the packages
+// the loader type-checks, and returns the counter.
+func countTypeChecked(lconf *loader.Config) *int32 {
+	var n int32
+	after := lconf.AfterTypeCheck
+	lconf.AfterTypeCheck = func(info *loader.PackageInfo, files []*ast.File) {
+		atomic.AddInt32(&n, 1)
+		if after != nil {
+			after(info, files)
+		}
+	}
+	return &n
+}
+
+// ssaProgram returns the SSA form of lprog, which is created but not
+// necessarily built; see buildSSA.  Without a Cache the program is
+// created in the specified mode.
+func (q *Query) ssaProgram(lprog *loader.Program, mode ssa.BuilderMode) *ssa.Program {
+	start := time.Now()
+	defer func() { tracef(q.context(), "SSA create: %v", time.Since(start)) }()
+	if q.Cache == nil {
+		return ssautil.CreateProgram(lprog, mode)
+	}
+	return q.Cache.ssaProgram(lprog)
+}
+
+// buildSSA builds the SSA code of every package of prog, unless the
+// query is cancelled first.  Packages that are already built, e.g. by
+// another query sharing prog, are not built again.  A panic while
+// building a package is returned as a *PanicError, and the cached
+// program, partly built, is discarded.
+func (q *Query) buildSSA(prog *ssa.Program) error {
+	ctx := q.context()
+	start := time.Now()
+	defer func() { tracef(ctx, "SSA build: %v", time.Since(start)) }()
+	pkgs := make(chan *ssa.Package)
+	var (
+		wg sync.WaitGroup
+		p  panics
+	)
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pkg := range pkgs {
+				if ctx.Err() == nil && p.error() == nil {
+					func() {
+						defer p.catch()
+						buildPackage(pkg)
+					}()
+				}
+			}
+		}()
+	}
+	for _, pkg := range prog.AllPackages() {
+		pkgs <- pkg
+	}
+	close(pkgs)
+	wg.Wait()
+	if err := p.error(); err != nil {
+		if q.Cache != nil {
+			q.Cache.discardSSA(prog)
+		}
+		return err
+	}
+	return ctx.Err()
+}
+
+// buildPackage builds the SSA code of pkg; tests replace it.
+var buildPackage = (*ssa.Package).Build
+
 // Create a pointer.Config whose scope is the initial packages of lprog
 // and their dependencies.
-func setupPTA(prog *ssa.Program, lprog *loader.Program, ptaLog io.Writer, reflection bool) (*pointer.Config, error) {
+func (q *Query) setupPTA(prog *ssa.Program, lprog *loader.Program) (*pointer.Config, error) {
+	if err := q.context().Err(); err != nil {
+		return nil, err
+	}
+	var mains []*ssa.Package
+	if q.Cache == nil {
+		mains = analysisMains(prog, lprog)
+	} else {
+		mains = q.Cache.ptaMains(prog, lprog)
+	}
+	if mains == nil {
+		return nil, scopeErrorf("analysis scope has no main and no tests")
+	}
+	return &pointer.Config{
+		Log:        q.PTALog,
+		Reflection: q.Reflection,
+		Mains:      mains,
+	}, nil
+}
+
+// ptrAnalysis returns the result of the pointer analysis specified by
+// conf, from q.Cache if possible.  A cached result answers the queries
+// of conf but is shared with other queries, so it must not be modified.
+// The call graph, if any, has no synthetic nodes.
+func (q *Query) ptrAnalysis(conf *pointer.Config) (*pointer.Result, error) {
+	ctx := q.context()
+	start := time.Now()
+	if q.Cache != nil {
+		if result, err := q.Cache.pointerAnalysis(conf); result != nil || err != nil {
+			tracef(ctx, "pointer analysis of the cached program: %v", time.Since(start))
+			return result, err
+		}
+	}
+	result, err := ptrAnalysis(conf)
+	tracef(ctx, "pointer analysis: %v", time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	if result.CallGraph != nil {
+		result.CallGraph.DeleteSyntheticNodes()
+	}
+	return result, nil
+}
+
+// analysisMains returns the pointer analysis roots of prog.
+func analysisMains(prog *ssa.Program, lprog *loader.Program) []*ssa.Package {
 	// For each initial package (specified on the command line),
 	// if it has a main function, analyze that,
 	// otherwise analyze its tests, if any.
@@ -142,14 +346,7 @@ func setupPTA(prog *ssa.Program, lprog *loader.Program, ptaLog io.Writer, reflec
 			mains = append(mains, main)
 		}
 	}
-	if mains == nil {
-		return nil, fmt.Errorf("analysis scope has no main and no tests")
-	}
-	return &pointer.Config{
-		Log:        ptaLog,
-		Reflection: reflection,
-		Mains:      mains,
-	}, nil
+	return mains
 }
 
 // importQueryPackage finds the package P containing the
@@ -190,7 +387,7 @@ func importQueryPackage(pos string, conf *loader.Config) (string, error) {
 		default:
 			// This happens for ad-hoc packages like
 			// $GOROOT/src/net/http/triv.go.
-			return "", fmt.Errorf("package %q doesn't contain file %s",
+			return "", notFoundErrorf("package %q doesn't contain file %s",
 				importPath, filename)
 		}
 	}
@@ -215,27 +412,23 @@ func pkgContainsFile(bp *build.Package, filename string) byte {
 
 // ParseQueryPos parses the source query position pos and returns the
 // AST node of the loaded program lprog that it identifies.
+// ctxt is used to read the file if pos is given in line:column form.
 // If needExact, it must identify a single AST subtree;
 // this is appropriate for queries that allow fairly arbitrary syntax,
 // e.g. "describe".
 //
-func parseQueryPos(lprog *loader.Program, pos string, needExact bool) (*QueryPos, error) {
-	filename, startOffset, endOffset, err := parsePos(pos)
+func parseQueryPos(lprog *loader.Program, ctxt *build.Context, pos string, needExact bool) (*QueryPos, error) {
+	filename, startOffset, endOffset, err := parsePos(ctxt, pos)
 	if err != nil {
 		return nil, err
 	}
 
 	// Find the named file among those in the loaded program.
-	var file *token.File
-	lprog.Fset.Iterate(func(f *token.File) bool {
-		if sameFile(filename, f.Name()) {
-			file = f
-			return false // done
-		}
-		return true // continue
-	})
+	// (The file set may also hold older versions of the file
+	// if the program was reloaded by a Cache.)
+	file := programFile(lprog, filename)
 	if file == nil {
-		return nil, fmt.Errorf("file %s not found in loaded program", filename)
+		return nil, notFoundErrorf("file %s not found in loaded program", filename)
 	}
 
 	start, end, err := fileOffsetToPos(file, startOffset, endOffset)
@@ -244,16 +437,29 @@ func parseQueryPos(lprog *loader.Program, pos string, needExact bool) (*QueryPos
 	}
 	info, path, exact := lprog.PathEnclosingInterval(start, end)
 	if path == nil {
-		return nil, fmt.Errorf("no syntax here")
+		return nil, posErrorf("no syntax here")
 	}
 	if needExact && !exact {
-		return nil, fmt.Errorf("ambiguous selection within %s", astutil.NodeDescription(path[0]))
+		return nil, posErrorf("ambiguous selection within %s", astutil.NodeDescription(path[0]))
 	}
 	return &QueryPos{lprog.Fset, start, end, path, exact, info}, nil
 }
 
 // ---------- Utilities ----------
 
+// programFile returns the token.File of the named source file of lprog,
+// or nil if there is none.
+func programFile(lprog *loader.Program, filename string) *token.File {
+	for _, info := range lprog.AllPackages {
+		for _, f := range info.Files {
+			if file := lprog.Fset.File(f.Pos()); file != nil && sameFile(filename, file.Name()) {
+				return file
+			}
+		}
+	}
+	return nil
+}
+
 // loadWithSoftErrors calls lconf.Load, suppressing "soft" errors.  (See Go issue 16530.)
 // TODO(adonovan): Once the loader has an option to allow soft errors,
 // replace calls to loadWithSoftErrors with loader calls with that parameter.
@@ -280,17 +486,104 @@ func loadWithSoftErrors(lconf *loader.Config) (*loader.Program, error) {
 		}
 	}
 	if errpkgs != nil {
-		var more string
-		if len(errpkgs) > 3 {
-			more = fmt.Sprintf(" and %d more", len(errpkgs)-3)
-			errpkgs = errpkgs[:3]
-		}
-		return nil, fmt.Errorf("couldn't load packages due to errors: %s%s",
-			strings.Join(errpkgs, ", "), more)
+		sort.Strings(errpkgs)
+		return nil, &LoadError{Packages: errpkgs}
 	}
 	return prog, err
 }
 
+// A LoadError is the error of a query whose program has packages with
+// errors that prevent its analysis.
+type LoadError struct {
+	Packages []string // import paths of the packages with errors, sorted
+}
+
+func (e *LoadError) Error() string {
+	pkgs := e.Packages
+	var more string
+	if len(pkgs) > 3 {
+		more = fmt.Sprintf(" and %d more", len(pkgs)-3)
+		pkgs = pkgs[:3]
+	}
+	return fmt.Sprintf("couldn't load packages due to errors: %s%s",
+		strings.Join(pkgs, ", "), more)
+}
+
+// A PosError is the error of a query whose position or selection does
+// not suit its mode, e.g. "no identifier here".
+type PosError struct {
+	msg string
+}
+
+func (e *PosError) Error() string { return e.msg }
+
+func posErrorf(format string, args ...interface{}) error {
+	return &PosError{fmt.Sprintf(format, args...)}
+}
+
+// A NotFoundError is the error of a query about something that is not
+// in its program, or not reachable in its analysis.
+type NotFoundError struct {
+	msg string
+}
+
+func (e *NotFoundError) Error() string { return e.msg }
+
+func notFoundErrorf(format string, args ...interface{}) error {
+	return &NotFoundError{fmt.Sprintf(format, args...)}
+}
+
+// A ScopeError is the error of a query whose scope prevents its
+// analysis, e.g. one with no main package for the pointer analysis.
+type ScopeError struct {
+	msg string
+}
+
+func (e *ScopeError) Error() string { return e.msg }
+
+func scopeErrorf(format string, args ...interface{}) error {
+	return &ScopeError{fmt.Sprintf(format, args...)}
+}
+
+// A PanicError is the error of a query that panicked on a goroutine
+// other than that of its caller, e.g. one building SSA code or one on
+// which the loader calls AfterTypeCheck, where the caller cannot
+// recover the panic.
+type PanicError struct {
+	Value interface{} // value passed to panic
+	Stack []byte      // stack of the goroutine that panicked
+}
+
+func (e *PanicError) Error() string { return fmt.Sprintf("panic: %v", e.Value) }
+
+// panics records the first panic of the goroutines of a query.
+type panics struct {
+	mu  sync.Mutex
+	err *PanicError
+}
+
+// catch recovers the panic of the calling goroutine, if any, and
+// records it.  It must be deferred directly.
+func (p *panics) catch() {
+	if v := recover(); v != nil {
+		p.mu.Lock()
+		if p.err == nil {
+			p.err = &PanicError{Value: v, Stack: debug.Stack()}
+		}
+		p.mu.Unlock()
+	}
+}
+
+// error returns the first panic recorded, as a *PanicError, or nil.
+func (p *panics) error() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err == nil {
+		return nil
+	}
+	return p.err
+}
+
 func containsHardErrors(errors []error) bool {
 	for _, err := range errors {
 		if err, ok := err.(types.Error); ok && err.Soft {
@@ -314,13 +607,14 @@ func allowErrors(lconf *loader.Config) {
 	lconf.TypeChecker.Error = func(err error) {}
 }
 
-// ptrAnalysis runs the pointer analysis and returns its result.
-func ptrAnalysis(conf *pointer.Config) *pointer.Result {
+// ptrAnalysis runs the pointer analysis and returns its result, or the
+// error of an internal error of the analysis.
+func ptrAnalysis(conf *pointer.Config) (*pointer.Result, error) {
 	result, err := pointer.Analyze(conf)
 	if err != nil {
-		panic(err) // pointer analysis internal error
+		return nil, fmt.Errorf("pointer analysis internal error: %v", err)
 	}
-	return result
+	return result, nil
 }
 
 func unparen(e ast.Expr) ast.Expr { return astutil.Unparen(e) }
@@ -348,6 +642,35 @@ func deref(typ types.Type) types.Type {
 // compilation-error-regexp in Emacs' compilation mode.
 //
 func fprintf(w io.Writer, fset *token.FileSet, pos interface{}, format string, args ...interface{}) {
+	editorFprintf(w, fset, Emacs, pos, format, args...)
+}
+
+// An Editor determines the syntax of the locations of plain output.
+type Editor int
+
+const (
+	// Emacs locations are "file:line.col-line.col: ", where the end
+	// column is inclusive, as Emacs' compilation mode expects.
+	// This is the output of guru.
+	Emacs Editor = iota
+
+	// Vim locations are "file:line:col: ", as the default errorformat
+	// of Vim's quickfix list expects.  Intervals are reduced to their
+	// start, and lines without position are printed as is.
+	Vim
+)
+
+// PrintPlain prints the plain text form of qr to w, with locations in
+// the syntax of editor.
+func PrintPlain(w io.Writer, fset *token.FileSet, qr QueryResult, editor Editor) {
+	qr.PrintPlain(func(pos interface{}, format string, args ...interface{}) {
+		editorFprintf(w, fset, editor, pos, format, args...)
+	})
+}
+
+// editorFprintf is like fprintf, but prints the location in the syntax
+// of editor.
+func editorFprintf(w io.Writer, fset *token.FileSet, editor Editor, pos interface{}, format string, args ...interface{}) {
 	var start, end token.Pos
 	switch pos := pos.(type) {
 	case ast.Node:
@@ -378,16 +701,18 @@ func fprintf(w io.Writer, fset *token.FileSet, pos interface{}, format string, a
 		panic(fmt.Sprintf("invalid pos: %T", pos))
 	}
 
-	if sp := fset.Position(start); start == end {
+	switch sp := fset.Position(start); {
+	case editor == Vim:
+		if sp.IsValid() {
+			fmt.Fprintf(w, "%s:%d:%d: ", sp.Filename, sp.Line, sp.Column)
+		}
+	case start == end:
 		// (prints "-: " for token.NoPos)
 		fmt.Fprintf(w, "%s: ", sp)
-	} else {
+	default:
 		ep := fset.Position(end)
 		// The -1 below is a concession to Emacs's broken use of
 		// inclusive (not half-open) intervals.
-		// Other editors may not want it.
-		// TODO(adonovan): add an -editor=vim|emacs|acme|auto
-		// flag; auto uses EMACS=t / VIM=... / etc env vars.
 		fmt.Fprintf(w, "%s:%d.%d-%d.%d: ",
 			sp.Filename, sp.Line, sp.Column, ep.Line, ep.Column-1)
 	}
@@ -395,10 +720,13 @@ func fprintf(w io.Writer, fset *token.FileSet, pos interface{}, format string, a
 	io.WriteString(w, "\n")
 }
 
+// toJSON returns the JSON encoding of x.  QueryResult.JSON cannot
+// return an error, so toJSON panics, rather than exiting, if x cannot be
+// encoded; a long-lived caller such as the god daemon recovers from it.
 func toJSON(x interface{}) []byte {
 	b, err := json.MarshalIndent(x, "", "\t")
 	if err != nil {
-		log.Fatalf("JSON error: %v", err)
+		panic(fmt.Errorf("JSON error: %v", err))
 	}
 	return b
 }
@@ -5,7 +5,6 @@
 package guru
 
 import (
-	"fmt"
 	"go/ast"
 	"go/token"
 	"go/types"
@@ -59,12 +58,12 @@ func Implements(q *Query) error {
 	}
 
 	// Load/parse/type-check the program.
-	lprog, err := lconf.Load()
+	lprog, err := q.load(&lconf, qpkg, (*loader.Config).Load)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, false)
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, false)
 	if err != nil {
 		return err
 	}
@@ -82,7 +81,7 @@ func Implements(q *Query) error {
 			if obj, ok := qpos.Info.ObjectOf(id).(*types.Func); ok {
 				recv := obj.Type().(*types.Signature).Recv()
 				if recv == nil {
-					return fmt.Errorf("this function is not a method")
+					return posErrorf("this function is not a method")
 				}
 				method = obj
 				T = recv.Type()
@@ -98,7 +97,7 @@ func Implements(q *Query) error {
 		T = qpos.Info.TypeOf(path[0].(ast.Expr))
 	}
 	if T == nil {
-		return fmt.Errorf("not a type, method, or value")
+		return posErrorf("not a type, method, or value")
 	}
 
 	// Find all named types, even local types (which can have
@@ -0,0 +1,80 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package guru
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// TestPanicReferrers checks that a panic of Output on a goroutine of
+// the loader, as in a global referrers query, is returned as a
+// *PanicError.
+func TestPanicReferrers(t *testing.T) {
+	gopath := newTestGOPATH(t)
+	defer gopath.remove()
+	const psrc = "package p\n\nvar X int\n"
+	pfile := gopath.write("p/p.go", psrc)
+	gopath.write("q/q.go", "package q\n\nimport \"p\"\n\nvar y = p.X\n")
+
+	buildContext := gopath.ctxt
+	q := &Query{
+		Pos:   fmt.Sprintf("%s:#%d", pfile, strings.Index(psrc, "X")),
+		Build: &buildContext,
+		Output: func(*token.FileSet, QueryResult) {
+			panic("output")
+		},
+	}
+	err := Referrers(q)
+	if err, ok := err.(*PanicError); !ok || err.Value != "output" || len(err.Stack) == 0 {
+		t.Errorf("got error %#v, want a *PanicError of value \"output\"", err)
+	}
+}
+
+// TestPanicBuildSSA checks that a panic while building SSA code, on a
+// goroutine of buildSSA, is returned as a *PanicError and discards the
+// cached program.
+func TestPanicBuildSSA(t *testing.T) {
+	gopath := newTestGOPATH(t)
+	defer gopath.remove()
+	const src = "package main\n\nfunc main() {\n\tp := new(int)\n\tprint(p)\n}\n"
+	filename := gopath.write("m/m.go", src)
+
+	buildContext := gopath.ctxt
+	cache := NewCache()
+	pointsto := func() error {
+		return Pointsto(&Query{
+			Pos:    fmt.Sprintf("%s:#%d", filename, strings.Index(src, "p)")),
+			Build:  &buildContext,
+			Scope:  []string{"m"},
+			Output: func(*token.FileSet, QueryResult) {},
+			Cache:  cache,
+		})
+	}
+
+	defer func(build func(*ssa.Package)) { buildPackage = build }(buildPackage)
+	buildPackage = func(pkg *ssa.Package) {
+		if pkg.Pkg.Path() == "m" {
+			panic("build")
+		}
+		pkg.Build()
+	}
+	err := pointsto()
+	if err, ok := err.(*PanicError); !ok || err.Value != "build" {
+		t.Errorf("got error %#v, want a *PanicError of value \"build\"", err)
+	}
+
+	buildPackage = (*ssa.Package).Build
+	if err := pointsto(); err != nil {
+		t.Fatal(err)
+	}
+	if got := cache.Stats().Loads; got != 2 {
+		t.Errorf("got %d loads, want 2: the partly built program is loaded again", got)
+	}
+}
@@ -31,30 +31,32 @@ func Peers(q *Query) error {
 	}
 
 	// Load/parse/type-check the program.
-	lprog, err := loadWithSoftErrors(&lconf)
+	lprog, err := q.load(&lconf, "", loadWithSoftErrors)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, false)
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, false)
 	if err != nil {
 		return err
 	}
 
-	prog := ssautil.CreateProgram(lprog, ssa.GlobalDebug)
+	prog := q.ssaProgram(lprog, ssa.GlobalDebug)
 
-	ptaConfig, err := setupPTA(prog, lprog, q.PTALog, q.Reflection)
+	ptaConfig, err := q.setupPTA(prog, lprog)
 	if err != nil {
 		return err
 	}
 
 	opPos := findOp(qpos)
 	if opPos == token.NoPos {
-		return fmt.Errorf("there is no channel operation here")
+		return posErrorf("there is no channel operation here")
 	}
 
 	// Defer SSA construction till after errors are reported.
-	prog.Build()
+	if err := q.buildSSA(prog); err != nil {
+		return err
+	}
 
 	var queryOp chanOp // the originating send or receive operation
 	var ops []chanOp   // all sends/receives of opposite direction
@@ -96,7 +98,10 @@ func Peers(q *Query) error {
 	ops = ops[:i]
 
 	// Run the pointer analysis.
-	ptares := ptrAnalysis(ptaConfig)
+	ptares, err := q.ptrAnalysis(ptaConfig)
+	if err != nil {
+		return err
+	}
 
 	// Find the points-to set.
 	queryChanPtr := ptares.Queries[queryOp.ch]
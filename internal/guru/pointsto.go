@@ -16,7 +16,6 @@ import (
 	"golang.org/x/tools/go/loader"
 	"golang.org/x/tools/go/pointer"
 	"golang.org/x/tools/go/ssa"
-	"golang.org/x/tools/go/ssa/ssautil"
 )
 
 // Pointsto runs the pointer analysis on the selected expression,
@@ -34,26 +33,26 @@ func Pointsto(q *Query) error {
 	}
 
 	// Load/parse/type-check the program.
-	lprog, err := loadWithSoftErrors(&lconf)
+	lprog, err := q.load(&lconf, "", loadWithSoftErrors)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, true) // needs exact pos
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, true) // needs exact pos
 	if err != nil {
 		return err
 	}
 
-	prog := ssautil.CreateProgram(lprog, ssa.GlobalDebug)
+	prog := q.ssaProgram(lprog, ssa.GlobalDebug)
 
-	ptaConfig, err := setupPTA(prog, lprog, q.PTALog, q.Reflection)
+	ptaConfig, err := q.setupPTA(prog, lprog)
 	if err != nil {
 		return err
 	}
 
 	path, action := findInterestingNode(qpos.Info, qpos.Path)
 	if action != actionExpr {
-		return fmt.Errorf("pointer analysis wants an expression; got %s",
+		return posErrorf("pointer analysis wants an expression; got %s",
 			astutil.NodeDescription(qpos.Path[0]))
 	}
 
@@ -62,7 +61,7 @@ func Pointsto(q *Query) error {
 	switch n := path[0].(type) {
 	case *ast.ValueSpec:
 		// ambiguous ValueSpec containing multiple names
-		return fmt.Errorf("multiple value specification")
+		return posErrorf("multiple value specification")
 	case *ast.Ident:
 		obj = qpos.Info.ObjectOf(n)
 		expr = n
@@ -77,7 +76,7 @@ func Pointsto(q *Query) error {
 	// TODO(adonovan): reject nil too.
 	typ := qpos.Info.TypeOf(expr)
 	if !pointer.CanPoint(typ) {
-		return fmt.Errorf("pointer analysis wants an expression of reference type; got %s", typ)
+		return posErrorf("pointer analysis wants an expression of reference type; got %s", typ)
 	}
 
 	// Determine the ssa.Value for the expression.
@@ -94,10 +93,12 @@ func Pointsto(q *Query) error {
 	}
 
 	// Defer SSA construction till after errors are reported.
-	prog.Build()
+	if err := q.buildSSA(prog); err != nil {
+		return err
+	}
 
 	// Run the pointer analysis.
-	ptrs, err := runPTA(ptaConfig, value, isAddr)
+	ptrs, err := q.runPTA(ptaConfig, value, isAddr)
 	if err != nil {
 		return err // e.g. analytically unreachable
 	}
@@ -122,12 +123,12 @@ func ssaValueForIdent(prog *ssa.Program, qinfo *loader.PackageInfo, obj types.Ob
 		if v, addr := prog.VarValue(obj, pkg, path); v != nil {
 			return v, addr, nil
 		}
-		return nil, false, fmt.Errorf("can't locate SSA Value for var %s", obj.Name())
+		return nil, false, notFoundErrorf("can't locate SSA Value for var %s", obj.Name())
 
 	case *types.Func:
 		fn := prog.FuncValue(obj)
 		if fn == nil {
-			return nil, false, fmt.Errorf("%s is an interface method", obj)
+			return nil, false, posErrorf("%s is an interface method", obj)
 		}
 		// TODO(adonovan): there's no point running PTA on a *Func ident.
 		// Eliminate this feature.
@@ -146,18 +147,18 @@ func ssaValueForExpr(prog *ssa.Program, qinfo *loader.PackageInfo, path []ast.No
 
 	fn := ssa.EnclosingFunction(pkg, path)
 	if fn == nil {
-		return nil, false, fmt.Errorf("no SSA function built for this location (dead code?)")
+		return nil, false, notFoundErrorf("no SSA function built for this location (dead code?)")
 	}
 
 	if v, addr := fn.ValueForExpr(path[0].(ast.Expr)); v != nil {
 		return v, addr, nil
 	}
 
-	return nil, false, fmt.Errorf("can't locate SSA Value for expression in %s", fn)
+	return nil, false, notFoundErrorf("can't locate SSA Value for expression in %s", fn)
 }
 
 // runPTA runs the pointer analysis of the selected SSA value or address.
-func runPTA(conf *pointer.Config, v ssa.Value, isAddr bool) (ptrs []pointerResult, err error) {
+func (q *Query) runPTA(conf *pointer.Config, v ssa.Value, isAddr bool) (ptrs []pointerResult, err error) {
 	T := v.Type()
 	if isAddr {
 		conf.AddIndirectQuery(v)
@@ -165,7 +166,10 @@ func runPTA(conf *pointer.Config, v ssa.Value, isAddr bool) (ptrs []pointerResul
 	} else {
 		conf.AddQuery(v)
 	}
-	ptares := ptrAnalysis(conf)
+	ptares, err := q.ptrAnalysis(conf)
+	if err != nil {
+		return nil, err
+	}
 
 	var ptr pointer.Pointer
 	if isAddr {
@@ -174,7 +178,7 @@ func runPTA(conf *pointer.Config, v ssa.Value, isAddr bool) (ptrs []pointerResul
 		ptr = ptares.Queries[v]
 	}
 	if ptr == (pointer.Pointer{}) {
-		return nil, fmt.Errorf("pointer analysis did not find expression (dead code?)")
+		return nil, notFoundErrorf("pointer analysis did not find expression (dead code?)")
 	}
 	pts := ptr.PointsTo()
 
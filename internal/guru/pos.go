@@ -7,12 +7,14 @@ package guru
 // This file defines utilities for working with file positions.
 
 import (
-	"fmt"
+	"bytes"
 	"go/build"
 	"go/parser"
 	"go/token"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -31,22 +33,36 @@ func parseOctothorpDecimal(s string) int {
 	return -1
 }
 
+// ParsePos parses a query position as accepted by every query mode and
+// returns its components.  See parsePos.
+func ParsePos(ctxt *build.Context, pos string) (filename string, startOffset, endOffset int, err error) {
+	return parsePos(ctxt, pos)
+}
+
+// lineColRe matches the line:column forms of query positions.
+var lineColRe = regexp.MustCompile(`^(.*):(\d+):(\d+)(?:,(\d+):(\d+))?$`)
+
 // parsePos parses a string of the form "file:pos" or
 // file:start,end" where pos, start, end match #%d and represent byte
 // offsets, and returns its components.
 //
-// (Numbers without a '#' prefix are reserved for future use,
-// e.g. to indicate line/column positions.)
+// pos, start and end may also be of the form line:col, where line and
+// col are 1-based and col counts bytes, e.g. "foo.go:12:3,12:9"; the
+// file is then read through ctxt to compute the offsets.
 //
-func parsePos(pos string) (filename string, startOffset, endOffset int, err error) {
+func parsePos(ctxt *build.Context, pos string) (filename string, startOffset, endOffset int, err error) {
 	if pos == "" {
-		err = fmt.Errorf("no source position specified")
+		err = posErrorf("no source position specified")
 		return
 	}
 
+	if m := lineColRe.FindStringSubmatch(pos); m != nil {
+		return parseLineColPos(ctxt, m)
+	}
+
 	colon := strings.LastIndex(pos, ":")
 	if colon < 0 {
-		err = fmt.Errorf("bad position syntax %q", pos)
+		err = posErrorf("bad position syntax %q", pos)
 		return
 	}
 	filename, offset := pos[:colon], pos[colon+1:]
@@ -62,12 +78,66 @@ func parsePos(pos string) (filename string, startOffset, endOffset int, err erro
 		endOffset = parseOctothorpDecimal(offset[comma+1:])
 	}
 	if startOffset < 0 || endOffset < 0 {
-		err = fmt.Errorf("invalid offset %q in query position", offset)
+		err = posErrorf("invalid offset %q in query position", offset)
 		return
 	}
 	return
 }
 
+// parseLineColPos returns the components of a query position matched
+// by lineColRe.
+func parseLineColPos(ctxt *build.Context, m []string) (filename string, startOffset, endOffset int, err error) {
+	filename = m[1]
+	rc, err := buildutil.OpenFile(ctxt, filename)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	defer rc.Close()
+	content, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	line, _ := strconv.Atoi(m[2])
+	col, _ := strconv.Atoi(m[3])
+	if startOffset, err = lineColOffset(content, line, col); err != nil {
+		return "", 0, 0, err
+	}
+	endOffset = startOffset
+	if m[4] != "" {
+		line, _ = strconv.Atoi(m[4])
+		col, _ = strconv.Atoi(m[5])
+		if endOffset, err = lineColOffset(content, line, col); err != nil {
+			return "", 0, 0, err
+		}
+	}
+	return filename, startOffset, endOffset, nil
+}
+
+// lineColOffset returns the byte offset in content of the 1-based line
+// and byte column.  The column may denote the end of the line.
+func lineColOffset(content []byte, line, col int) (int, error) {
+	if line < 1 || col < 1 {
+		return -1, posErrorf("invalid line:column %d:%d in query position", line, col)
+	}
+	offset := 0
+	for i := 1; i < line; i++ {
+		nl := bytes.IndexByte(content[offset:], '\n')
+		if nl < 0 {
+			return -1, posErrorf("line %d is beyond end of file", line)
+		}
+		offset += nl + 1
+	}
+	eol := bytes.IndexByte(content[offset:], '\n')
+	if eol < 0 {
+		eol = len(content) - offset
+	}
+	if col-1 > eol {
+		return -1, posErrorf("column %d is beyond end of line %d", col, line)
+	}
+	return offset + col - 1, nil
+}
+
 // fileOffsetToPos translates the specified file-relative byte offsets
 // into token.Pos form.  It returns an error if the file was not found
 // or the offsets were out of bounds.
@@ -78,14 +148,14 @@ func fileOffsetToPos(file *token.File, startOffset, endOffset int) (start, end t
 	if 0 <= startOffset && startOffset <= file.Size() {
 		start = file.Pos(int(startOffset))
 	} else {
-		err = fmt.Errorf("start position is beyond end of file")
+		err = posErrorf("start position is beyond end of file")
 		return
 	}
 
 	if 0 <= endOffset && endOffset <= file.Size() {
 		end = file.Pos(int(endOffset))
 	} else {
-		err = fmt.Errorf("end position is beyond end of file")
+		err = posErrorf("end position is beyond end of file")
 		return
 	}
 
@@ -109,7 +179,7 @@ func sameFile(x, y string) bool {
 // fastQueryPos parses the position string and returns a queryPos.
 // It parses only a single file and does not run the type checker.
 func fastQueryPos(ctxt *build.Context, pos string) (*QueryPos, error) {
-	filename, startOffset, endOffset, err := parsePos(pos)
+	filename, startOffset, endOffset, err := parsePos(ctxt, pos)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +195,7 @@ func fastQueryPos(ctxt *build.Context, pos string) (*QueryPos, error) {
 		return nil, err
 	}
 	if !f.Pos().IsValid() {
-		return nil, fmt.Errorf("%s is not a Go source file", filename)
+		return nil, posErrorf("%s is not a Go source file", filename)
 	}
 
 	start, end, err := fileOffsetToPos(fset.File(f.Pos()), startOffset, endOffset)
@@ -135,7 +205,7 @@ func fastQueryPos(ctxt *build.Context, pos string) (*QueryPos, error) {
 
 	path, exact := astutil.PathEnclosingInterval(f, start, end)
 	if path == nil {
-		return nil, fmt.Errorf("no syntax here")
+		return nil, posErrorf("no syntax here")
 	}
 
 	return &QueryPos{fset, start, end, path, exact, nil}, nil
@@ -0,0 +1,52 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package guru
+
+import "testing"
+
+func TestParsePos(t *testing.T) {
+	gopath := newTestGOPATH(t)
+	defer gopath.remove()
+	filename := gopath.write("p/p.go", "package p\n\nvar x, yy int\n")
+
+	tests := []struct {
+		pos        string
+		start, end int
+		err        bool
+	}{
+		{pos: ":#12", start: 12, end: 12},
+		{pos: ":#15,#17", start: 15, end: 17},
+		{pos: ":3:5", start: 15, end: 15},
+		{pos: ":3:8,3:10", start: 18, end: 20},
+		{pos: ":1:1", start: 0, end: 0},
+		{pos: ":3:14", start: 24, end: 24}, // end of line
+		{pos: ":3:15", err: true},
+		{pos: ":5:1", err: true},
+		{pos: ":0:1", err: true},
+		{pos: ":12", err: true},
+		{pos: "", err: true},
+	}
+	for _, test := range tests {
+		pos := filename + test.pos
+		if test.pos == "" {
+			pos = ""
+		}
+		name, start, end, err := parsePos(&gopath.ctxt, pos)
+		if test.err {
+			if err == nil {
+				t.Errorf("parsePos(%q) succeeded, want error", test.pos)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parsePos(%q): %v", test.pos, err)
+			continue
+		}
+		if name != filename || start != test.start || end != test.end {
+			t.Errorf("parsePos(%q) = %s, %d, %d; want %s, %d, %d",
+				test.pos, name, start, end, filename, test.start, test.end)
+		}
+	}
+}
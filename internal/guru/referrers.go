@@ -6,13 +6,13 @@ package guru
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/build"
 	"go/token"
 	"go/types"
 	"io"
-	"log"
 	"sort"
 	"strings"
 	"sync"
@@ -26,31 +26,40 @@ import (
 // Referrers reports all identifiers that resolve to the same object
 // as the queried identifier, within any package in the workspace.
 func Referrers(q *Query) error {
-	fset := token.NewFileSet()
-	lconf := loader.Config{Fset: fset, Build: q.Build}
+	lconf := loader.Config{Build: q.Build}
 	allowErrors(&lconf)
 
-	if _, err := importQueryPackage(q.Pos, &lconf); err != nil {
+	qpkg, err := importQueryPackage(q.Pos, &lconf)
+	if err != nil {
 		return err
 	}
 
 	// Load/parse/type-check the query package.
-	lprog, err := lconf.Load()
+	lprog, err := q.load(&lconf, qpkg, (*loader.Config).Load)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, false)
+	fset := lprog.Fset
+
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, false)
 	if err != nil {
 		return err
 	}
 
 	id, _ := qpos.Path[0].(*ast.Ident)
 	if id == nil {
-		return fmt.Errorf("no identifier here")
+		return posErrorf("no identifier here")
 	}
 
 	obj := qpos.Info.ObjectOf(id)
+
+	// Any package of the workspace may come to import the query
+	// package, so the result depends on more than what is loaded.
+	if searchesWorkspace(obj) {
+		q.Versions.MarkIncomplete()
+	}
+
 	if obj == nil {
 		// Happens for y in "switch y := x.(type)",
 		// the package declaration,
@@ -58,7 +67,7 @@ func Referrers(q *Query) error {
 		if _, ok := qpos.Path[1].(*ast.File); ok { // package decl?
 			return packageReferrers(q, qpos.Info.Pkg.Path())
 		}
-		return fmt.Errorf("no object for identifier: %T", qpos.Path[1])
+		return notFoundErrorf("no object for identifier: %T", qpos.Path[1])
 	}
 
 	// Imported package name?
@@ -67,7 +76,7 @@ func Referrers(q *Query) error {
 	}
 
 	if obj.Pkg() == nil {
-		return fmt.Errorf("references to predeclared %q are everywhere!", obj.Name())
+		return posErrorf("references to predeclared %q are everywhere!", obj.Name())
 	}
 
 	// For a globally accessible object defined in package P, we
@@ -109,6 +118,17 @@ func classify(obj types.Object) (global, pkglevel bool) {
 	return false, false
 }
 
+// searchesWorkspace reports whether the references to obj, or to the
+// declared package if obj is nil, are searched for throughout the
+// workspace.
+func searchesWorkspace(obj types.Object) bool {
+	if _, ok := obj.(*types.PkgName); ok || obj == nil {
+		return true
+	}
+	global, _ := classify(obj)
+	return global && obj.Pkg() != nil
+}
+
 // packageReferrers reports all references to the specified package
 // throughout the workspace.
 func packageReferrers(q *Query, path string) error {
@@ -142,11 +162,16 @@ func packageReferrers(q *Query, path string) error {
 	// TODO(adonovan): what about import cycles?
 	var qpkg *types.Package
 
+	// The loader calls AfterTypeCheck on goroutines of its own, whose
+	// panics are returned as the error of the query.
+	var p panics
+
 	// For efficiency, we scan each package for references
 	// just after it has been type-checked.  The loader calls
 	// AfterTypeCheck (concurrently), providing us with a stream of
 	// packages.
 	lconf.AfterTypeCheck = func(info *loader.PackageInfo, files []*ast.File) {
+		defer p.catch()
 		// AfterTypeCheck may be called twice for the same package due to augmentation.
 
 		if info.Pkg.Path() == path && qpkg == nil {
@@ -177,10 +202,17 @@ func packageReferrers(q *Query, path string) error {
 		clearInfoFields(info) // save memory
 	}
 
+	cancelable(q.context(), &lconf)
 	lconf.Load() // ignore error
+	if err := p.error(); err != nil {
+		return err
+	}
+	if err := q.context().Err(); err != nil {
+		return err
+	}
 
 	if qpkg == nil {
-		log.Fatalf("query package %q not found during reloading", path)
+		return fmt.Errorf("query package %q not found during reloading", path)
 	}
 
 	return nil
@@ -261,9 +293,11 @@ func globalReferrers(q *Query, qpkg, defpkg string, objposn token.Position, isPk
 	// to completion.
 
 	var (
-		mu    sync.Mutex
-		qobj  types.Object
-		qinfo *loader.PackageInfo // info for qpkg
+		mu       sync.Mutex
+		qobj     types.Object
+		qinfo    *loader.PackageInfo // info for qpkg
+		notFound error               // of the query object in defpkg
+		p        panics              // of the AfterTypeCheck calls
 	)
 
 	// For efficiency, we scan each package for references
@@ -271,32 +305,35 @@ func globalReferrers(q *Query, qpkg, defpkg string, objposn token.Position, isPk
 	// AfterTypeCheck (concurrently), providing us with a stream of
 	// packages.
 	lconf.AfterTypeCheck = func(info *loader.PackageInfo, files []*ast.File) {
+		defer p.catch()
 		// AfterTypeCheck may be called twice for the same package due to augmentation.
 
 		// Only inspect packages that depend on the declaring package
 		// (and thus were type-checked).
 		if lconf.TypeCheckFuncBodies(info.Pkg.Path()) {
 			// Record the query object and its package when we see it.
-			mu.Lock()
-			if qobj == nil && info.Pkg.Path() == defpkg {
-				// Find the object by its position (slightly ugly).
-				qobj = findObject(fset, &info.Info, objposn)
-				if qobj == nil {
-					// It really ought to be there;
-					// we found it once already.
-					log.Fatalf("object at %s not found in package %s",
-						objposn, defpkg)
+			obj := func() types.Object {
+				mu.Lock()
+				defer mu.Unlock() // even if Output panics
+				if qobj == nil && notFound == nil && info.Pkg.Path() == defpkg {
+					// Find the object by its position (slightly ugly).
+					qobj = findObject(fset, &info.Info, objposn)
+					if qobj == nil {
+						// It really ought to be there;
+						// we found it once already.
+						notFound = fmt.Errorf("object at %s not found in package %s",
+							objposn, defpkg)
+					} else {
+						// Object found.
+						qinfo = info
+						q.Output(fset, &referrersInitialResult{
+							qinfo: qinfo,
+							obj:   qobj,
+						})
+					}
 				}
-
-				// Object found.
-				qinfo = info
-				q.Output(fset, &referrersInitialResult{
-					qinfo: qinfo,
-					obj:   qobj,
-				})
-			}
-			obj := qobj
-			mu.Unlock()
+				return qobj
+			}()
 
 			// Look for references to the query object.
 			if obj != nil {
@@ -307,10 +344,20 @@ func globalReferrers(q *Query, qpkg, defpkg string, objposn token.Position, isPk
 		clearInfoFields(info) // save memory
 	}
 
+	cancelable(q.context(), &lconf)
 	lconf.Load() // ignore error
+	if err := p.error(); err != nil {
+		return err
+	}
+	if err := q.context().Err(); err != nil {
+		return err
+	}
 
+	if notFound != nil {
+		return notFound
+	}
 	if qobj == nil {
-		log.Fatal("query object not found during reloading")
+		return errors.New("query object not found during reloading")
 	}
 
 	return nil // success
@@ -0,0 +1,276 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package guru
+
+// This file defines reload, which updates a loaded program after some
+// of its files have changed by type-checking only the affected packages.
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/build"
+	"go/types"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/buildutil"
+	"golang.org/x/tools/go/loader"
+)
+
+// errReload is returned by reload when the changes cannot be applied
+// incrementally and the program must be loaded from scratch.
+var errReload = errors.New("program must be reloaded")
+
+// A reloadPackage is a package of the program being reloaded.
+type reloadPackage struct {
+	old   *loader.PackageInfo
+	info  *loader.PackageInfo // new PackageInfo, if dirty
+	dir   string
+	deps  []*reloadPackage // dirty packages imported by this one
+	state int              // 0: unvisited, 1: visiting, 2: done (for topological sort)
+}
+
+// reload returns a copy of lprog, which was loaded according to conf,
+// in which the packages containing the changed files or the dirty
+// import paths, and all packages that depend on them, are type-checked
+// again from source.  The other packages, and their types.Package
+// objects, are shared with lprog.  It also returns the number of
+// packages that were type-checked.
+//
+// reload returns errReload if the changes cannot be applied without
+// loading the program from scratch, for instance because a package's
+// imports now include a package not in lprog, and the error of ctx if
+// it is cancelled.
+func reload(ctx context.Context, conf *loader.Config, lprog *loader.Program, changed, dirty map[string]bool) (*loader.Program, int, error) {
+	if conf.AfterTypeCheck != nil {
+		return nil, 0, errReload // the hook expects to see every package
+	}
+	ctxt := conf.Build
+	if ctxt == nil {
+		ctxt = &build.Default
+	}
+	fset := lprog.Fset
+
+	// Index the packages of lprog by import path and by file,
+	// and record who imports whom.
+	pkgs := make(map[string]*reloadPackage)
+	byPkg := make(map[*types.Package]*reloadPackage)
+	importers := make(map[*types.Package][]*reloadPackage)
+	var roots []*reloadPackage
+	for pkg, info := range lprog.AllPackages {
+		p := &reloadPackage{old: info}
+		pkgs[pkg.Path()] = p
+		byPkg[pkg] = p
+		for _, imp := range pkg.Imports() {
+			importers[imp] = append(importers[imp], p)
+		}
+		isRoot := dirty[strings.TrimSuffix(pkg.Path(), "_test")]
+		for _, f := range info.Files {
+			name := fset.File(f.Pos()).Name()
+			p.dir = filepath.Dir(name)
+			if changed[name] {
+				isRoot = true
+			}
+		}
+		if isRoot {
+			roots = append(roots, p)
+		}
+	}
+
+	// Mark the roots and their importers dirty.
+	var mark func(p *reloadPackage)
+	mark = func(p *reloadPackage) {
+		if p.info != nil {
+			return
+		}
+		p.info = &loader.PackageInfo{
+			Pkg:        types.NewPackage(p.old.Pkg.Path(), ""),
+			Importable: p.old.Importable,
+			Info: types.Info{
+				Types:      make(map[ast.Expr]types.TypeAndValue),
+				Defs:       make(map[*ast.Ident]types.Object),
+				Uses:       make(map[*ast.Ident]types.Object),
+				Implicits:  make(map[ast.Node]types.Object),
+				Scopes:     make(map[ast.Node]*types.Scope),
+				Selections: make(map[*ast.SelectorExpr]*types.Selection),
+			},
+		}
+		for _, imp := range importers[p.old.Pkg] {
+			mark(imp)
+		}
+	}
+	for _, p := range roots {
+		mark(p)
+	}
+
+	// Parse the dirty packages again and resolve their imports,
+	// which must all be packages of lprog.
+	var dirtyPkgs []*reloadPackage
+	for _, p := range pkgs {
+		if p.info == nil {
+			continue
+		}
+		dirtyPkgs = append(dirtyPkgs, p)
+		if bp, err := ctxt.ImportDir(p.dir, 0); err != nil || (ctxt.CgoEnabled && len(bp.CgoFiles) > 0) {
+			return nil, 0, errReload // cgo-processed files, or not a package directory
+		}
+		for _, f := range p.old.Files {
+			name := fset.File(f.Pos()).Name()
+			if changed[name] {
+				if ok, err := ctxt.MatchFile(p.dir, filepath.Base(name)); err != nil || !ok {
+					return nil, 0, errReload // build constraints changed
+				}
+			}
+			file, err := buildutil.ParseFile(fset, ctxt, conf.DisplayPath, p.dir, name, conf.ParserMode)
+			if file == nil {
+				return nil, 0, errReload
+			}
+			if err != nil {
+				p.info.Errors = append(p.info.Errors, err)
+			}
+			p.info.Files = append(p.info.Files, file)
+
+			for _, spec := range file.Imports {
+				path, _ := strconv.Unquote(spec.Path.Value)
+				if path == "unsafe" {
+					continue
+				}
+				dep := resolve(ctxt, pkgs, path, p.dir)
+				if dep == nil {
+					return nil, 0, errReload // new dependency
+				}
+				if dep.info != nil {
+					p.deps = append(p.deps, dep)
+				}
+			}
+		}
+	}
+
+	// Type-check the dirty packages in dependency order.
+	var order []*reloadPackage
+	var visit func(p *reloadPackage) bool
+	visit = func(p *reloadPackage) bool {
+		switch p.state {
+		case 1:
+			return false // import cycle
+		case 2:
+			return true
+		}
+		p.state = 1
+		for _, dep := range p.deps {
+			if !visit(dep) {
+				return false
+			}
+		}
+		p.state = 2
+		order = append(order, p)
+		return true
+	}
+	for _, p := range dirtyPkgs {
+		if !visit(p) {
+			return nil, 0, errReload
+		}
+	}
+	for _, p := range order {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		info := p.info
+		tc := conf.TypeChecker // copy
+		tc.IgnoreFuncBodies = false
+		if f := conf.TypeCheckFuncBodies; f != nil {
+			tc.IgnoreFuncBodies = !f(info.Pkg.Path())
+		}
+		tc.Importer = reloadImporter{ctxt, pkgs, p.dir}
+		tc.Error = func(err error) {
+			if conf.TypeChecker.Error != nil {
+				conf.TypeChecker.Error(err)
+			}
+			info.Errors = append(info.Errors, err)
+		}
+		types.NewChecker(&tc, fset, info.Pkg, &info.Info).Files(info.Files)
+
+		if len(info.Errors) > 0 && !conf.AllowErrors {
+			return nil, 0, errReload // let Load report the errors
+		}
+	}
+
+	// Assemble the new program.
+	newInfo := func(info *loader.PackageInfo) *loader.PackageInfo {
+		if p := byPkg[info.Pkg]; p.info != nil {
+			return p.info
+		}
+		return info
+	}
+	prog := &loader.Program{
+		Fset:        fset,
+		Imported:    make(map[string]*loader.PackageInfo, len(lprog.Imported)),
+		AllPackages: make(map[*types.Package]*loader.PackageInfo, len(lprog.AllPackages)),
+	}
+	for _, info := range lprog.Created {
+		prog.Created = append(prog.Created, newInfo(info))
+	}
+	for path, info := range lprog.Imported {
+		prog.Imported[path] = newInfo(info)
+	}
+	for _, p := range pkgs {
+		info := p.old
+		if p.info != nil {
+			info = p.info
+		}
+		prog.AllPackages[info.Pkg] = info
+	}
+
+	// A package is transitively error-free if it and its imports are.
+	for _, p := range order {
+		p.info.TransitivelyErrorFree = len(p.info.Errors) == 0
+		for _, imp := range p.info.Pkg.Imports() {
+			if info := prog.AllPackages[imp]; info != nil && !info.TransitivelyErrorFree {
+				p.info.TransitivelyErrorFree = false
+			}
+		}
+	}
+
+	return prog, len(order), nil
+}
+
+// resolve returns the package of pkgs denoted by the import path
+// appearing in a file in directory dir, or nil if there is none.
+func resolve(ctxt *build.Context, pkgs map[string]*reloadPackage, path, dir string) *reloadPackage {
+	bp, err := ctxt.Import(path, dir, build.FindOnly)
+	if err != nil {
+		return nil
+	}
+	return pkgs[bp.ImportPath]
+}
+
+// A reloadImporter is the types.Importer used by reload.
+// It returns the new package for each dirty import and the existing
+// package for each clean one.
+type reloadImporter struct {
+	ctxt *build.Context
+	pkgs map[string]*reloadPackage
+	dir  string
+}
+
+func (imp reloadImporter) Import(path string) (*types.Package, error) {
+	return imp.ImportFrom(path, imp.dir, 0)
+}
+
+func (imp reloadImporter) ImportFrom(path, dir string, mode types.ImportMode) (*types.Package, error) {
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+	p := resolve(imp.ctxt, imp.pkgs, path, imp.dir)
+	if p == nil {
+		return nil, notFoundErrorf("no package %q in program", path)
+	}
+	if p.info != nil {
+		return p.info.Pkg, nil
+	}
+	return p.old.Pkg, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package guru
+
+import (
+	"go/build"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/loader"
+)
+
+// Versions records the versions of the source files that a query
+// depends on: those of the programs it loads and of the files it parses
+// on its own.  Its result can be reused for as long as none of these
+// files changes.
+//
+// The methods of a nil *Versions do nothing.
+type Versions struct {
+	mu         sync.Mutex
+	ctxt       *build.Context         // of the query
+	files      map[string]fileVersion // source file -> version when read
+	dirs       map[string]string      // package directory -> its Go files when read
+	pkgs       map[string]bool        // import paths of the packages of files
+	incomplete bool
+}
+
+// MarkIncomplete records that the result of the query depends on more
+// than the files of v, e.g. because it was answered from an index.
+func (v *Versions) MarkIncomplete() {
+	if v == nil {
+		return
+	}
+	v.mu.Lock()
+	v.incomplete = true
+	v.mu.Unlock()
+}
+
+// Complete reports whether v holds every file that the result of the
+// query depends on.  It is false if the query read no file at all.
+func (v *Versions) Complete() bool {
+	if v == nil {
+		return false
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return !v.incomplete && v.ctxt != nil
+}
+
+// Changed reports whether the content of some file of v differs from
+// the one the query read, or a package directory of v gained or lost
+// files.
+func (v *Versions) Changed() bool {
+	if v == nil {
+		return true
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for dir, names := range v.dirs {
+		if goFiles(v.ctxt, dir) != names {
+			return true
+		}
+	}
+	for name, fv := range v.files {
+		if statVersion(v.ctxt, name) != fv {
+			return true
+		}
+	}
+	return false
+}
+
+// DependsOn reports whether v holds files of one of the packages with
+// the import paths in paths, as reported to Cache.Invalidate.
+func (v *Versions) DependsOn(paths map[string]bool) bool {
+	if v == nil {
+		return false
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for path := range v.pkgs {
+		if paths[path] {
+			return true
+		}
+	}
+	return false
+}
+
+// add records files and dirs, read in ctxt, as belonging to the
+// packages pkgs.  The first version of a file read twice is kept, so
+// that a query that saw both versions is never reused.
+func (v *Versions) add(ctxt *build.Context, files map[string]fileVersion, dirs map[string]string, pkgs []string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.ctxt == nil {
+		v.ctxt = ctxt
+		v.files = make(map[string]fileVersion)
+		v.dirs = make(map[string]string)
+		v.pkgs = make(map[string]bool)
+	}
+	for name, fv := range files {
+		if _, ok := v.files[name]; !ok {
+			v.files[name] = fv
+		}
+	}
+	for dir, names := range dirs {
+		if _, ok := v.dirs[dir]; !ok {
+			v.dirs[dir] = names
+		}
+	}
+	for _, path := range pkgs {
+		v.pkgs[path] = true
+	}
+}
+
+// addEntry records the files of the cached program e, as read in ctxt.
+func (v *Versions) addEntry(ctxt *build.Context, e *cacheEntry) {
+	if v == nil || e.err != nil {
+		return
+	}
+	v.add(ctxt, e.files, e.dirs, programPackages(e.lprog))
+}
+
+// addProgram records the files of lprog, which was loaded in ctxt
+// without a Cache.
+func (v *Versions) addProgram(ctxt *build.Context, lprog *loader.Program) {
+	if v == nil || lprog == nil {
+		return
+	}
+	files, dirs, _ := programVersions(ctxt, lprog)
+	v.add(ctxt, files, dirs, programPackages(lprog))
+}
+
+// addFile records the source file filename, parsed in ctxt outside of
+// any program.
+func (v *Versions) addFile(ctxt *build.Context, filename string) {
+	if v == nil {
+		return
+	}
+	var pkgs []string
+	if _, importPath, err := guessImportPath(filename, ctxt); err == nil {
+		pkgs = append(pkgs, importPath)
+	}
+	files := map[string]fileVersion{filename: statVersion(ctxt, filename)}
+	dirs := map[string]string{filepath.Dir(filename): goFiles(ctxt, filepath.Dir(filename))}
+	v.add(ctxt, files, dirs, pkgs)
+}
+
+// programPackages returns the import paths of the packages of lprog,
+// test packages included under the path of the package they test.
+func programPackages(lprog *loader.Program) []string {
+	var paths []string
+	for pkg := range lprog.AllPackages {
+		paths = append(paths, strings.TrimSuffix(pkg.Path(), "_test"))
+	}
+	return paths
+}
@@ -29,6 +29,7 @@ func What(q *Query) error {
 	if err != nil {
 		return err
 	}
+	q.Versions.addFile(q.Build, qpos.Fset.File(qpos.Start).Name())
 
 	// (ignore errors)
 	srcdir, importPath, _ := guessImportPath(qpos.Fset.File(qpos.Start).Name(), q.Build)
@@ -206,13 +207,13 @@ func guessImportPath(filename string, buildContext *build.Context) (srcdir, impo
 		}
 	}
 	if srcdir == "" {
-		return "", "", fmt.Errorf("directory %s is not beneath any of these GOROOT/GOPATH directories: %s",
+		return "", "", scopeErrorf("directory %s is not beneath any of these GOROOT/GOPATH directories: %s",
 			filepath.Dir(absFile), strings.Join(buildContext.SrcDirs(), ", "))
 	}
 	if importPath == "" {
 		// This happens for e.g. $GOPATH/src/a.go, but
 		// "" is not a valid path for (*go/build).Import.
-		return "", "", fmt.Errorf("cannot load package in root of source directory %s", srcdir)
+		return "", "", scopeErrorf("cannot load package in root of source directory %s", srcdir)
 	}
 	return srcdir, importPath, nil
 }
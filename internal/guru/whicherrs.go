@@ -37,26 +37,26 @@ func Whicherrs(q *Query) error {
 	}
 
 	// Load/parse/type-check the program.
-	lprog, err := loadWithSoftErrors(&lconf)
+	lprog, err := q.load(&lconf, "", loadWithSoftErrors)
 	if err != nil {
 		return err
 	}
 
-	qpos, err := parseQueryPos(lprog, q.Pos, true) // needs exact pos
+	qpos, err := parseQueryPos(lprog, q.Build, q.Pos, true) // needs exact pos
 	if err != nil {
 		return err
 	}
 
-	prog := ssautil.CreateProgram(lprog, ssa.GlobalDebug)
+	prog := q.ssaProgram(lprog, ssa.GlobalDebug)
 
-	ptaConfig, err := setupPTA(prog, lprog, q.PTALog, q.Reflection)
+	ptaConfig, err := q.setupPTA(prog, lprog)
 	if err != nil {
 		return err
 	}
 
 	path, action := findInterestingNode(qpos.Info, qpos.Path)
 	if action != actionExpr {
-		return fmt.Errorf("whicherrs wants an expression; got %s",
+		return posErrorf("whicherrs wants an expression; got %s",
 			astutil.NodeDescription(qpos.Path[0]))
 	}
 	var expr ast.Expr
@@ -64,7 +64,7 @@ func Whicherrs(q *Query) error {
 	switch n := path[0].(type) {
 	case *ast.ValueSpec:
 		// ambiguous ValueSpec containing multiple names
-		return fmt.Errorf("multiple value specification")
+		return posErrorf("multiple value specification")
 	case *ast.Ident:
 		obj = qpos.Info.ObjectOf(n)
 		expr = n
@@ -76,7 +76,7 @@ func Whicherrs(q *Query) error {
 
 	typ := qpos.Info.TypeOf(expr)
 	if !types.Identical(typ, builtinErrorType) {
-		return fmt.Errorf("selection is not an expression of type 'error'")
+		return posErrorf("selection is not an expression of type 'error'")
 	}
 	// Determine the ssa.Value for the expression.
 	var value ssa.Value
@@ -91,7 +91,9 @@ func Whicherrs(q *Query) error {
 	}
 
 	// Defer SSA construction till after errors are reported.
-	prog.Build()
+	if err := q.buildSSA(prog); err != nil {
+		return err
+	}
 
 	globals := findVisibleErrs(prog, qpos)
 	constants := findVisibleConsts(prog, qpos)
@@ -139,10 +141,13 @@ func Whicherrs(q *Query) error {
 		ptaConfig.AddQuery(v)
 	}
 
-	ptares := ptrAnalysis(ptaConfig)
+	ptares, err := q.ptrAnalysis(ptaConfig)
+	if err != nil {
+		return err
+	}
 	valueptr := ptares.Queries[value]
 	if valueptr == (pointer.Pointer{}) {
-		return fmt.Errorf("pointer analysis did not find expression (dead code?)")
+		return notFoundErrorf("pointer analysis did not find expression (dead code?)")
 	}
 	for g, v := range globals {
 		ptr, ok := ptares.Queries[v]
@@ -0,0 +1,502 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package index implements a persistent index of the definitions and
+// references of the identifiers of a workspace.
+//
+// The index records, for each source file, the hash, size and
+// modification time of the content it was built from, so that it can
+// answer queries on unchanged files without loading any program, e.g.
+// while the god daemon is starting.
+package index
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/zchee/god/internal/buildkey"
+
+	"golang.org/x/tools/go/buildutil"
+	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/refactor/importgraph"
+)
+
+// version is the version of the index file format.
+const version = 2
+
+// Dir returns the directory that holds the index files:
+// $XDG_CACHE_HOME/god, or $HOME/.cache/god by default.
+func Dir() string {
+	dir := os.Getenv("XDG_CACHE_HOME")
+	if dir == "" {
+		dir = filepath.Join(os.Getenv("HOME"), ".cache")
+	}
+	return filepath.Join(dir, "god")
+}
+
+// An Index holds the definitions and references of the files of the
+// workspace described by a build context.
+// An Index is safe for concurrent use.
+type Index struct {
+	hits, misses int64 // lookups; accessed atomically
+
+	ctxt *build.Context
+	path string // name of the index file
+
+	mu       sync.RWMutex
+	files    map[string]*File  // keyed by file name
+	modified bool              // files changed since the last Save
+	rev      importgraph.Graph // reverse import graph of the workspace, or nil
+	revGen   int               // incremented by InvalidateGraph
+	building bool              // rev is being built
+}
+
+// A File is the index of one source file.
+type File struct {
+	Hash    string    // hex SHA-1 of the content the index was built from
+	Size    int64     // of the file when it was indexed
+	ModTime time.Time // of the file when it was indexed
+	Package string    // import path of the package
+	Idents  []Ident   // in order of Offset
+}
+
+// An Ident is an occurrence of an identifier in a File.
+type Ident struct {
+	Name        string
+	Offset, End int    // byte offsets of the identifier
+	Line, Col   int    // position of the identifier
+	Def         bool   // identifier declares the object
+	ObjPos      string // position of the declaration of the object
+	Desc        string // description of the object, e.g. "var x int"
+}
+
+// A Ref is a reference found in the index.
+type Ref struct {
+	Package string // import path of the package of the reference
+	Pos     string // position of the reference
+	Text    string // text of the referring line
+}
+
+// Open returns the index of the workspace described by ctxt, stored in
+// directory dir.  The index is empty if it has not been saved before,
+// or if the file cannot be read.
+func Open(dir string, ctxt *build.Context) (*Index, error) {
+	x := &Index{
+		ctxt:  ctxt,
+		path:  filepath.Join(dir, workspaceKey(ctxt)+".gob"),
+		files: make(map[string]*File),
+	}
+	f, err := os.Open(x.path)
+	if os.IsNotExist(err) {
+		return x, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var v int
+	dec := gob.NewDecoder(f)
+	if err := dec.Decode(&v); err != nil || v != version {
+		return x, nil // start afresh
+	}
+	if err := dec.Decode(&x.files); err != nil {
+		x.files = make(map[string]*File)
+	}
+	return x, nil
+}
+
+// workspaceKey returns a file name that identifies the workspace ctxt.
+func workspaceKey(ctxt *build.Context) string {
+	h := sha1.Sum([]byte(buildkey.Key(ctxt)))
+	return "index-" + hex.EncodeToString(h[:])[:16]
+}
+
+// Save writes the index to its file, if it has changed.
+func (x *Index) Save() error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if !x.modified {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(version); err != nil {
+		return err
+	}
+	if err := enc.Encode(x.files); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(x.path), 0700); err != nil {
+		return err
+	}
+	tmp := x.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, x.path); err != nil {
+		return err
+	}
+	x.modified = false
+	return nil
+}
+
+// Len returns the number of files in the index.
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.files)
+}
+
+// Stale discards the files whose content has changed since they were
+// indexed and returns the import paths of the packages that must be
+// indexed again, in sorted order.
+func (x *Index) Stale() []string {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	pkgs := make(map[string]bool)
+	for name, f := range x.files {
+		if x.fresh(name, f) {
+			continue
+		}
+		content, err := x.readFile(name)
+		if fi, serr := os.Stat(name); err == nil && serr == nil && hash(content) == f.Hash {
+			f.Size, f.ModTime = fi.Size(), fi.ModTime() // touched
+			x.modified = true
+			continue
+		}
+		delete(x.files, name)
+		x.modified = true
+		if err == nil {
+			pkgs[f.Package] = true
+		}
+	}
+	var paths []string
+	for path := range pkgs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Update indexes the files of pkgs, which must have been type-checked
+// including function bodies, unless they are already indexed at their
+// current content.  Files in GOROOT are not indexed.  Update returns
+// the number of files indexed.
+func (x *Index) Update(fset *token.FileSet, pkgs []*loader.PackageInfo) int {
+	goroot := filepath.Join(x.ctxt.GOROOT, "src") + string(filepath.Separator)
+	n := 0
+	for _, info := range pkgs {
+		files := make(map[*token.File][]Ident)
+		for _, f := range info.Files {
+			if tf := fset.File(f.Pos()); tf != nil {
+				files[tf] = nil
+			}
+		}
+		if len(files) == 0 {
+			continue
+		}
+
+		// Record every identifier that denotes an object.
+		// If an identifier is both a use and a def (anonymous
+		// fields), prefer the use, as the definition query does.
+		objs := make(map[*ast.Ident]types.Object)
+		for id, obj := range info.Defs {
+			if obj != nil {
+				objs[id] = obj
+			}
+		}
+		for id, obj := range info.Uses {
+			objs[id] = obj
+		}
+		for id, obj := range objs {
+			if !obj.Pos().IsValid() {
+				continue // built-in
+			}
+			tf := fset.File(id.Pos())
+			if _, ok := files[tf]; !ok {
+				continue
+			}
+			posn := fset.Position(id.Pos())
+			files[tf] = append(files[tf], Ident{
+				Name:   id.Name,
+				Offset: posn.Offset,
+				End:    posn.Offset + len(id.Name),
+				Line:   posn.Line,
+				Col:    posn.Column,
+				Def:    obj.Pos() == id.Pos(),
+				ObjPos: fset.Position(obj.Pos()).String(),
+				Desc:   types.ObjectString(obj, types.RelativeTo(info.Pkg)),
+			})
+		}
+
+		for tf, idents := range files {
+			name := tf.Name()
+			if strings.HasPrefix(name, goroot) {
+				continue
+			}
+			fi, err := os.Stat(name)
+			if err != nil {
+				continue
+			}
+			content, err := x.readFile(name)
+			if err != nil || len(content) != tf.Size() || fi.Size() != int64(tf.Size()) {
+				continue // changed since it was parsed
+			}
+			h := hash(content)
+
+			x.mu.Lock()
+			if f := x.files[name]; f == nil || f.Hash != h {
+				sort.Sort(byOffset(idents))
+				x.files[name] = &File{
+					Hash:    h,
+					Size:    fi.Size(),
+					ModTime: fi.ModTime(),
+					Package: strings.TrimSuffix(info.Pkg.Path(), "_test"),
+					Idents:  idents,
+				}
+				x.modified = true
+				n++
+			}
+			x.mu.Unlock()
+		}
+	}
+	return n
+}
+
+// Definition returns the position and description of the object
+// denoted by the identifier at the specified offset of the named file,
+// if the file is indexed at its current content.
+func (x *Index) Definition(filename string, offset int) (objPos, desc string, ok bool) {
+	x.mu.RLock()
+	f := x.files[filename]
+	x.mu.RUnlock()
+	if f == nil || !x.fresh(filename, f) {
+		return "", "", x.count(false)
+	}
+	id := f.ident(offset)
+	if id == nil {
+		return "", "", x.count(false)
+	}
+	return id.ObjPos, id.Desc, x.count(true)
+}
+
+// Referrers returns the position of the object denoted by the
+// identifier at the specified offset of the named file, and the
+// references to it, in order of file name and position.  ok is false
+// unless every file of the packages that may refer to the object, the
+// package that declares it and, if it is exported, the packages that
+// depend on that one, is indexed at its current content.  It is also
+// false while the import graph of the workspace, built on first use,
+// is not ready.
+func (x *Index) Referrers(filename string, offset int) (objPos string, refs []Ref, ok bool) {
+	x.mu.RLock()
+	f := x.files[filename]
+	x.mu.RUnlock()
+	if f == nil || !x.fresh(filename, f) {
+		return "", nil, x.count(false)
+	}
+	id := f.ident(offset)
+	if id == nil {
+		return "", nil, x.count(false)
+	}
+	objPos = id.ObjPos
+	names, ok := x.users(objPos, id.Name)
+	if !ok {
+		return "", nil, x.count(false)
+	}
+
+	for _, name := range names {
+		x.mu.RLock()
+		f := x.files[name]
+		x.mu.RUnlock()
+		var lines [][]byte
+		for _, id := range f.Idents {
+			if id.Def || id.ObjPos != objPos {
+				continue
+			}
+			if lines == nil {
+				content, err := x.readFile(name)
+				if err != nil || hash(content) != f.Hash {
+					return "", nil, x.count(false)
+				}
+				lines = bytes.Split(content, []byte("\n"))
+			}
+			refs = append(refs, Ref{
+				Package: f.Package,
+				Pos:     fmt.Sprintf("%s:%d:%d", name, id.Line, id.Col),
+				Text:    string(lines[id.Line-1]),
+			})
+		}
+	}
+	return objPos, refs, x.count(true)
+}
+
+// users returns the sorted names of the files that may refer to the
+// object named name declared at objPos: those of its package and, if
+// it is exported, of the packages of the workspace that depend on its
+// package.  ok is false unless they are all indexed at their current
+// content.
+func (x *Index) users(objPos, name string) (names []string, ok bool) {
+	declFile := objPos
+	for i := 0; i < 2; i++ { // strip :line:col
+		if j := strings.LastIndex(declFile, ":"); j >= 0 {
+			declFile = declFile[:j]
+		}
+	}
+	x.mu.RLock()
+	decl := x.files[declFile]
+	x.mu.RUnlock()
+	if decl == nil {
+		return nil, false // e.g. in GOROOT
+	}
+
+	pkgs := map[string]bool{decl.Package: true}
+	if r, _ := utf8.DecodeRuneInString(name); unicode.IsUpper(r) {
+		// Fields and methods are also selected through the packages
+		// that depend on the declaring one indirectly.
+		rev := x.graph()
+		if rev == nil {
+			return nil, false
+		}
+		pkgs = rev.Search(decl.Package)
+	}
+	for path := range pkgs {
+		bp, err := x.ctxt.Import(path, "", 0)
+		if err != nil {
+			return nil, false
+		}
+		for _, list := range [][]string{bp.GoFiles, bp.CgoFiles, bp.TestGoFiles, bp.XTestGoFiles} {
+			for _, base := range list {
+				name := filepath.Join(bp.Dir, base)
+				x.mu.RLock()
+				f := x.files[name]
+				x.mu.RUnlock()
+				if f == nil || !x.fresh(name, f) {
+					return nil, false
+				}
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, true
+}
+
+// InvalidateGraph discards the reverse import graph of the workspace,
+// e.g. because the imports of its files changed.
+func (x *Index) InvalidateGraph() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.rev = nil
+	x.revGen++
+}
+
+// graph returns the reverse import graph of the workspace or, until it
+// is built, nil, and builds it in the background.
+func (x *Index) graph() importgraph.Graph {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.rev == nil && !x.building {
+		x.building = true
+		go x.buildGraph()
+	}
+	return x.rev
+}
+
+// buildGraph builds the reverse import graph of the workspace, again
+// as long as InvalidateGraph is called meanwhile.
+func (x *Index) buildGraph() {
+	for {
+		x.mu.RLock()
+		gen := x.revGen
+		x.mu.RUnlock()
+		_, rev, _ := importgraph.Build(x.ctxt)
+
+		x.mu.Lock()
+		if x.revGen == gen {
+			x.rev = rev
+			x.building = false
+			x.mu.Unlock()
+			return
+		}
+		x.mu.Unlock()
+	}
+}
+
+// count counts a lookup that the index could answer if ok, and returns ok.
+func (x *Index) count(ok bool) bool {
+	if ok {
+		atomic.AddInt64(&x.hits, 1)
+	} else {
+		atomic.AddInt64(&x.misses, 1)
+	}
+	return ok
+}
+
+// Stats returns the number of lookups the index answered, and of those
+// it could not answer because a file was not indexed at its current
+// content.
+func (x *Index) Stats() (hits, misses int64) {
+	return atomic.LoadInt64(&x.hits), atomic.LoadInt64(&x.misses)
+}
+
+// fresh reports whether the named file still has the size and
+// modification time it was indexed at.
+func (x *Index) fresh(name string, f *File) bool {
+	fi, err := os.Stat(name)
+	return err == nil && fi.Size() == f.Size && fi.ModTime().Equal(f.ModTime)
+}
+
+// ident returns the identifier of f that contains offset, or nil.
+func (f *File) ident(offset int) *Ident {
+	i := sort.Search(len(f.Idents), func(i int) bool { return f.Idents[i].End >= offset })
+	if i < len(f.Idents) && f.Idents[i].Offset <= offset {
+		return &f.Idents[i]
+	}
+	return nil
+}
+
+func (x *Index) readFile(name string) ([]byte, error) {
+	rc, err := buildutil.OpenFile(x.ctxt, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, rc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func hash(content []byte) string {
+	h := sha1.Sum(content)
+	return hex.EncodeToString(h[:])
+}
+
+type byOffset []Ident
+
+func (s byOffset) Len() int           { return len(s) }
+func (s byOffset) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byOffset) Less(i, j int) bool { return s[i].Offset < s[j].Offset }
@@ -0,0 +1,156 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package index
+
+import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/tools/go/loader"
+)
+
+func TestIndex(t *testing.T) {
+	gopath, err := ioutil.TempDir("", "god-index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(gopath)
+
+	write := func(name, content string) string {
+		name = filepath.Join(gopath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return name
+	}
+	const psrc = "package p\n\nvar X int\n"
+	const qsrc = "package q\n\nimport \"p\"\n\nvar y = p.X\n\nvar z = p.X + y\n"
+	pfile := write("p/p.go", psrc)
+	qfile := write("q/q.go", qsrc)
+
+	ctxt := build.Default
+	ctxt.GOPATH = gopath
+	dir := filepath.Join(gopath, "cache")
+
+	x, err := Open(dir, &ctxt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := loader.Config{Build: &ctxt}
+	conf.Import("q")
+	lprog, err := conf.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pkgs []*loader.PackageInfo
+	for _, info := range lprog.AllPackages {
+		pkgs = append(pkgs, info)
+	}
+	if n := x.Update(lprog.Fset, pkgs); n != 2 {
+		t.Errorf("Update indexed %d files, want 2", n)
+	}
+	if n := x.Update(lprog.Fset, pkgs); n != 0 {
+		t.Errorf("second Update indexed %d files, want 0", n)
+	}
+
+	const wantPos = ":3:5" // of p.X
+	objPos, desc, ok := x.Definition(qfile, strings.Index(qsrc, "X"))
+	if !ok || !strings.HasSuffix(objPos, "p.go"+wantPos) || desc != "var p.X int" {
+		t.Errorf("Definition = %q, %q, %t; want p.go%s, \"var p.X int\"", objPos, desc, ok, wantPos)
+	}
+	if _, _, ok := x.Definition(qfile, strings.Index(qsrc, "import")); ok {
+		t.Errorf("Definition of keyword succeeded")
+	}
+
+	// The references to the exported X wait for the import graph.
+	if _, _, ok := x.Referrers(pfile, strings.Index(psrc, "X")); ok {
+		t.Errorf("Referrers without the import graph succeeded")
+	}
+	waitGraph(x)
+	objPos, refs, ok := x.Referrers(pfile, strings.Index(psrc, "X"))
+	if !ok || !strings.HasSuffix(objPos, "p.go"+wantPos) {
+		t.Fatalf("Referrers = %q, %t; want p.go%s", objPos, ok, wantPos)
+	}
+	want := []Ref{
+		{Package: "q", Pos: qfile + ":5:11", Text: "var y = p.X"},
+		{Package: "q", Pos: qfile + ":7:11", Text: "var z = p.X + y"},
+	}
+	if !reflect.DeepEqual(refs, want) {
+		t.Errorf("Referrers = %+v, want %+v", refs, want)
+	}
+
+	// A package that comes to depend on p and is not indexed makes the
+	// references incomplete.
+	rfile := write("r/r.go", "package r\n\nimport \"p\"\n\nvar w = p.X\n")
+	x.InvalidateGraph()
+	waitGraph(x)
+	if _, _, ok := x.Referrers(pfile, strings.Index(psrc, "X")); ok {
+		t.Errorf("Referrers with an unindexed importer succeeded")
+	}
+	if err := os.Remove(rfile); err != nil {
+		t.Fatal(err)
+	}
+
+	// The index persists across Open.
+	if err := x.Save(); err != nil {
+		t.Fatal(err)
+	}
+	x, err = Open(dir, &ctxt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := x.Len(); n != 2 {
+		t.Errorf("reopened index has %d files, want 2", n)
+	}
+	if stale := x.Stale(); len(stale) != 0 {
+		t.Errorf("Stale = %v, want none", stale)
+	}
+
+	// A modified file is no longer used, and its package is stale.
+	write("q/q.go", qsrc+"\n")
+	if _, _, ok := x.Definition(qfile, strings.Index(qsrc, "X")); ok {
+		t.Errorf("Definition in modified file succeeded")
+	}
+	if _, _, ok := x.Referrers(pfile, strings.Index(psrc, "X")); ok {
+		t.Errorf("Referrers in modified file succeeded")
+	}
+	if stale := x.Stale(); !reflect.DeepEqual(stale, []string{"q"}) {
+		t.Errorf("Stale = %v, want [q]", stale)
+	}
+	if n := x.Len(); n != 1 {
+		t.Errorf("index has %d files after Stale, want 1", n)
+	}
+}
+
+// waitGraph waits for the import graph of x.
+func waitGraph(x *Index) {
+	for x.graph() == nil {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDir(t *testing.T) {
+	defer os.Setenv("XDG_CACHE_HOME", os.Getenv("XDG_CACHE_HOME"))
+	defer os.Setenv("HOME", os.Getenv("HOME"))
+
+	os.Setenv("XDG_CACHE_HOME", "/xdg")
+	if got, want := Dir(), filepath.Join("/xdg", "god"); got != want {
+		t.Errorf("Dir() = %s, want %s", got, want)
+	}
+	os.Setenv("XDG_CACHE_HOME", "")
+	os.Setenv("HOME", "/home")
+	if got, want := Dir(), filepath.Join("/home", ".cache", "god"); got != want {
+		t.Errorf("Dir() = %s, want %s", got, want)
+	}
+}
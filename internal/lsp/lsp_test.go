@@ -0,0 +1,204 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zchee/god"
+)
+
+// TestTranscript replays the session of testdata/transcript.txt against
+// a god daemon whose GOPATH is testdata.
+func TestTranscript(t *testing.T) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "god-lsp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	defer func(gopath, cacheHome string) {
+		build.Default.GOPATH = gopath
+		os.Setenv("XDG_CACHE_HOME", cacheHome)
+	}(build.Default.GOPATH, os.Getenv("XDG_CACHE_HOME"))
+	build.Default.GOPATH = gopath
+	os.Setenv("XDG_CACHE_HOME", filepath.Join(dir, "cache"))
+
+	daemon := god.NewServer()
+	daemon.Addr = filepath.Join(dir, "god.sock")
+	errc := make(chan error, 1)
+	go func() { errc <- daemon.Start() }()
+	defer func() {
+		daemon.Stop()
+		if err := <-errc; err != nil {
+			t.Errorf("daemon: %v", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	var client *god.Client
+	for client == nil {
+		client, err = god.Connect(ctx, daemon.Addr, god.WithoutStart())
+		if err != nil {
+			if ctx.Err() != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	defer client.Close()
+
+	filename := filepath.Join(gopath, "src", "p", "p.go")
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, _ := json.Marshal(string(content))
+	expand := strings.NewReplacer("$URI", pathToURI(filename), "$TEXT", string(text)).Replace
+
+	script, err := ioutil.ReadFile(filepath.Join("testdata", "transcript.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inr, inw := io.Pipe()
+	outr, outw := io.Pipe()
+	servec := make(chan error, 1)
+	go func() {
+		err := NewServer(client).Serve(ctx, inr, outw)
+		outw.Close()
+		servec <- err
+	}()
+	out := newConn(outr, inw)
+
+	for i, line := range strings.Split(string(script), "\n") {
+		line = expand(line)
+		switch {
+		case strings.HasPrefix(line, "--> "):
+			if err := out.write(json.RawMessage(line[4:])); err != nil {
+				t.Fatalf("line %d: %v", i+1, err)
+			}
+		case strings.HasPrefix(line, "<-- "):
+			got, err := out.read()
+			if err != nil {
+				t.Fatalf("line %d: %v", i+1, err)
+			}
+			if !jsonEqual(t, got, []byte(line[4:])) {
+				t.Errorf("line %d: got response\n\t%s\nwant\n\t%s", i+1, got, line[4:])
+			}
+		}
+	}
+	if err := <-servec; err != nil {
+		t.Errorf("Serve: %v", err)
+	}
+}
+
+// jsonEqual reports whether the JSON values x and y are equal.
+func jsonEqual(t *testing.T, x, y []byte) bool {
+	var vx, vy interface{}
+	if err := json.Unmarshal(x, &vx); err != nil {
+		t.Fatalf("%s: %v", x, err)
+	}
+	if err := json.Unmarshal(y, &vy); err != nil {
+		t.Fatalf("%s: %v", y, err)
+	}
+	return reflect.DeepEqual(vx, vy)
+}
+
+func TestExitWithoutShutdown(t *testing.T) {
+	var in bytes.Buffer
+	newConn(nil, &in).write(&request{JSONRPC: "2.0", Method: "exit"})
+	if err := NewServer(nil).Serve(context.Background(), &in, ioutil.Discard); err != ErrNoShutdown {
+		t.Errorf("Serve: got error %v, want %v", err, ErrNoShutdown)
+	}
+}
+
+func TestOffset(t *testing.T) {
+	content := []byte("a\n\"\xf0\x9f\x98\x80\u00e9\" x\n")
+	for _, test := range []struct {
+		pos       Position
+		offset    int
+		line, col int // guru position of offset
+	}{
+		{Position{0, 0}, 0, 1, 1},
+		{Position{0, 1}, 1, 1, 2},
+		{Position{1, 0}, 2, 2, 1},
+		{Position{1, 1}, 3, 2, 2},
+		{Position{1, 3}, 7, 2, 6}, // after the emoji: two UTF-16 code units, four bytes
+		{Position{1, 6}, 11, 2, 10},
+		{Position{2, 0}, 13, 3, 1},
+	} {
+		got, err := offset(content, test.pos)
+		if err != nil || got != test.offset {
+			t.Errorf("offset(%v) = %d, %v, want %d", test.pos, got, err, test.offset)
+		}
+		if got := position(content, test.line, test.col); got != test.pos {
+			t.Errorf("position(%d, %d) = %v, want %v", test.line, test.col, got, test.pos)
+		}
+	}
+	if _, err := offset(content, Position{3, 0}); err == nil {
+		t.Error("offset of a line beyond the end: got no error")
+	}
+}
+
+func TestDirtyDocuments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "god-lsp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "p.go")
+	if err := ioutil.WriteFile(filename, []byte("package p\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	uri := pathToURI(filename)
+
+	s := NewServer(nil)
+	for _, test := range []struct {
+		method  string
+		params  interface{}
+		overlay bool
+	}{
+		{"textDocument/didOpen", DidOpenTextDocumentParams{TextDocument: TextDocumentItem{URI: uri, Text: "package p\n"}}, false},
+		{"textDocument/didChange", DidChangeTextDocumentParams{
+			TextDocument:   VersionedTextDocumentIdentifier{URI: uri},
+			ContentChanges: []TextDocumentContentChangeEvent{{Text: "package q\n"}},
+		}, true},
+		{"textDocument/didSave", DidSaveTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: uri}}, false},
+		{"textDocument/didClose", DidCloseTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: uri}}, false},
+		{"textDocument/didOpen", DidOpenTextDocumentParams{TextDocument: TextDocumentItem{URI: uri, Text: "package r\n"}}, true},
+	} {
+		params, err := json.Marshal(test.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.notify(&request{Method: test.method, Params: params}); err != nil {
+			t.Fatalf("%s: %v", test.method, err)
+		}
+		q, err := s.newQuery(&TextDocumentPositionParams{TextDocument: TextDocumentIdentifier{URI: uri}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if overlay := len(q.opts) > 0; overlay != test.overlay {
+			t.Errorf("after %s: got overlay %t, want %t", test.method, overlay, test.overlay)
+		}
+	}
+}
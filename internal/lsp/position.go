@@ -0,0 +1,141 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lsp
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// uriToPath returns the file name denoted by a file URI.
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported URI %q", uri)
+	}
+	path := u.Path
+	if runtime.GOOS == "windows" {
+		path = strings.TrimPrefix(path, "/") // "/C:/dir" -> "C:/dir"
+	}
+	return filepath.FromSlash(path), nil
+}
+
+// pathToURI returns the file URI of a file name.
+func pathToURI(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path // Windows drive letter
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
+
+// offset returns the byte offset in content of pos, whose character
+// counts UTF-16 code units.  A position beyond the end of its line
+// denotes the end of the line.
+func offset(content []byte, pos Position) (int, error) {
+	start := 0
+	for line := 0; line < pos.Line; line++ {
+		i := bytes.IndexByte(content[start:], '\n')
+		if i < 0 {
+			return 0, fmt.Errorf("line %d beyond end of file", pos.Line+1)
+		}
+		start += i + 1
+	}
+	off := start
+	for units := 0; units < pos.Character && off < len(content); {
+		r, size := utf8.DecodeRune(content[off:])
+		if r == '\n' {
+			break
+		}
+		units += utf16Len(r)
+		off += size
+	}
+	return off, nil
+}
+
+// position returns the Position of the 1-based line and byte column
+// col in content.
+func position(content []byte, line, col int) Position {
+	start := 0
+	for l := 1; l < line; l++ {
+		i := bytes.IndexByte(content[start:], '\n')
+		if i < 0 {
+			break
+		}
+		start += i + 1
+	}
+	end := start + col - 1
+	if end > len(content) {
+		end = len(content)
+	}
+	units := 0
+	for _, r := range string(content[start:end]) {
+		units += utf16Len(r)
+	}
+	return Position{Line: line - 1, Character: units}
+}
+
+// identRange returns the range of the identifier that starts at the
+// 1-based line and byte column col of content, or an empty range at
+// that position if there is none.
+func identRange(content []byte, line, col int) Range {
+	start := position(content, line, col)
+	end := start
+	off, err := offset(content, start)
+	if err != nil {
+		return Range{Start: start, End: end}
+	}
+	for off < len(content) {
+		r, size := utf8.DecodeRune(content[off:])
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		end.Character += utf16Len(r)
+		off += size
+	}
+	return Range{Start: start, End: end}
+}
+
+// identAt returns the identifier that starts at the 1-based line and
+// byte column col of content, or "" if there is none.
+func identAt(content []byte, line, col int) string {
+	start := 0
+	for l := 1; l < line; l++ {
+		i := bytes.IndexByte(content[start:], '\n')
+		if i < 0 {
+			return ""
+		}
+		start += i + 1
+	}
+	start += col - 1
+	if start > len(content) {
+		return ""
+	}
+	end := start
+	for end < len(content) {
+		r, size := utf8.DecodeRune(content[end:])
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		end += size
+	}
+	return string(content[start:end])
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,533 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lsp implements a Language Server Protocol frontend to the god
+// daemon, for editors without a god client.
+//
+// The server answers textDocument/definition, references, hover,
+// implementation and documentHighlight requests with the definition,
+// referrers, describe, implements and what queries of the daemon.  The
+// contents of open documents that differ from the files on disk are
+// sent along with each query.
+package lsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/zchee/god"
+	"github.com/zchee/god/internal/log"
+	serialpb "github.com/zchee/god/serial"
+)
+
+// A Client runs the queries of the server, e.g. a *god.Client.
+type Client interface {
+	Definition(ctx context.Context, pos string, opts ...god.Option) (*serialpb.Definition, error)
+	Implements(ctx context.Context, pos string, opts ...god.Option) (*serialpb.Implements, error)
+	What(ctx context.Context, pos string, opts ...god.Option) (*serialpb.What, error)
+	StreamReferrers(ctx context.Context, pos string, fn func(*serialpb.ReferrersEvent), opts ...god.Option) error
+	Describe(ctx context.Context, pos string, opts ...god.Option) (*serialpb.Describe, error)
+}
+
+// ErrNoShutdown is returned by Serve if the client exits without
+// requesting a shutdown first.
+var ErrNoShutdown = errors.New("exit without shutdown")
+
+// A Server is a language server.
+type Server struct {
+	client Client
+	opts   []god.Option
+	conn   *conn
+
+	mu          sync.Mutex
+	docs        map[string]*document          // open documents, keyed by file name
+	pending     map[string]context.CancelFunc // requests in progress, keyed by ID
+	initialized bool
+	shutdown    bool
+}
+
+// NewServer returns a server that runs its queries with client, and
+// opts in addition to the overlays of open documents.
+func NewServer(client Client, opts ...god.Option) *Server {
+	return &Server{
+		client:  client,
+		opts:    opts,
+		docs:    make(map[string]*document),
+		pending: make(map[string]context.CancelFunc),
+	}
+}
+
+// A document is an open document.
+type document struct {
+	content []byte // never modified in place
+	dirty   bool   // content differs from the file
+}
+
+// Serve reads requests from r and writes responses to w until the client
+// sends an exit notification or closes r.  Requests run concurrently, on
+// the documents as they are when the request arrives.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		content, err := s.conn.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var req request
+		if err := json.Unmarshal(content, &req); err != nil {
+			s.conn.reply(nil, nil, errorf(codeParseError, "%v", err))
+			continue
+		}
+		log.Debugf("lsp: %s", req.Method)
+
+		if req.ID == nil {
+			if req.Method == "exit" {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				if !s.shutdown {
+					return ErrNoShutdown
+				}
+				return nil
+			}
+			if err := s.notify(&req); err != nil {
+				log.Debugf("lsp: %s: %v", req.Method, err)
+			}
+			continue
+		}
+
+		// Take the state the request depends on now, and
+		// answer it in the background.
+		handler, err := s.handler(&req)
+		if err != nil {
+			s.conn.reply(req.ID, nil, err)
+			continue
+		}
+		reqCtx, cancel := context.WithCancel(ctx)
+		id := string(*req.ID)
+		s.mu.Lock()
+		s.pending[id] = cancel
+		s.mu.Unlock()
+		wg.Add(1)
+		go func(req request) {
+			defer wg.Done()
+			result, err := handler(reqCtx)
+			s.mu.Lock()
+			delete(s.pending, id)
+			s.mu.Unlock()
+			if reqCtx.Err() == context.Canceled && ctx.Err() == nil {
+				err = errorf(codeRequestCancelled, "request cancelled")
+			}
+			cancel()
+			if err := s.conn.reply(req.ID, result, err); err != nil {
+				log.Debugf("lsp: %v", err)
+			}
+		}(req)
+	}
+}
+
+// A handlerFunc computes the result of a request.
+type handlerFunc func(ctx context.Context) (interface{}, error)
+
+// handler returns the function that answers req.
+func (s *Server) handler(req *request) (handlerFunc, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case req.Method == "initialize":
+		s.initialized = true
+		return func(context.Context) (interface{}, error) {
+			return &InitializeResult{
+				Capabilities: ServerCapabilities{
+					TextDocumentSync: TextDocumentSyncOptions{
+						OpenClose: true,
+						Change:    SyncIncremental,
+						Save:      &SaveOptions{},
+					},
+					HoverProvider:             true,
+					DefinitionProvider:        true,
+					ReferencesProvider:        true,
+					ImplementationProvider:    true,
+					DocumentHighlightProvider: true,
+				},
+			}, nil
+		}, nil
+	case !s.initialized:
+		return nil, errorf(codeServerNotInitialized, "server not initialized")
+	case s.shutdown:
+		return nil, errorf(codeInvalidRequest, "server is shut down")
+	case req.Method == "shutdown":
+		s.shutdown = true
+		return func(context.Context) (interface{}, error) { return nil, nil }, nil
+	}
+
+	switch req.Method {
+	case "textDocument/definition", "textDocument/references", "textDocument/hover",
+		"textDocument/implementation", "textDocument/documentHighlight":
+	default:
+		return nil, errorf(codeMethodNotFound, "method not found: %s", req.Method)
+	}
+	var params ReferenceParams // or TextDocumentPositionParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, errorf(codeInvalidParams, "%v", err)
+	}
+	q, err := s.newQuery(&params.TextDocumentPositionParams)
+	if err != nil {
+		return nil, errorf(codeInvalidParams, "%v", err)
+	}
+	switch req.Method {
+	case "textDocument/definition":
+		return q.definition, nil
+	case "textDocument/references":
+		return func(ctx context.Context) (interface{}, error) {
+			return q.references(ctx, params.Context.IncludeDeclaration)
+		}, nil
+	case "textDocument/hover":
+		return q.hover, nil
+	case "textDocument/implementation":
+		return q.implementation, nil
+	default: // "textDocument/documentHighlight"
+		return q.documentHighlight, nil
+	}
+}
+
+// notify handles the notification req.
+func (s *Server) notify(req *request) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch req.Method {
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return err
+		}
+		filename, err := uriToPath(params.TextDocument.URI)
+		if err != nil {
+			return err
+		}
+		// An editor may open a buffer with unsaved changes.
+		content := []byte(params.TextDocument.Text)
+		disk, err := ioutil.ReadFile(filename)
+		s.docs[filename] = &document{
+			content: content,
+			dirty:   err != nil || !bytes.Equal(disk, content),
+		}
+
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return err
+		}
+		filename, err := uriToPath(params.TextDocument.URI)
+		if err != nil {
+			return err
+		}
+		doc, ok := s.docs[filename]
+		if !ok {
+			return fmt.Errorf("change of unopened document %s", filename)
+		}
+		content := doc.content
+		for _, change := range params.ContentChanges {
+			if content, err = applyChange(content, change); err != nil {
+				return err
+			}
+		}
+		s.docs[filename] = &document{content: content, dirty: true}
+
+	case "textDocument/didSave":
+		var params DidSaveTextDocumentParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return err
+		}
+		filename, err := uriToPath(params.TextDocument.URI)
+		if err != nil {
+			return err
+		}
+		doc, ok := s.docs[filename]
+		if !ok {
+			return fmt.Errorf("save of unopened document %s", filename)
+		}
+		content := doc.content
+		if params.Text != nil {
+			content = []byte(*params.Text)
+		}
+		s.docs[filename] = &document{content: content}
+
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return err
+		}
+		filename, err := uriToPath(params.TextDocument.URI)
+		if err != nil {
+			return err
+		}
+		delete(s.docs, filename)
+
+	case "$/cancelRequest":
+		var params struct {
+			ID *json.RawMessage `json:"id"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil || params.ID == nil {
+			return err
+		}
+		if cancel := s.pending[string(*params.ID)]; cancel != nil {
+			cancel()
+		}
+	}
+	return nil
+}
+
+// applyChange returns content with change applied.
+func applyChange(content []byte, change TextDocumentContentChangeEvent) ([]byte, error) {
+	if change.Range == nil {
+		return []byte(change.Text), nil
+	}
+	start, err := offset(content, change.Range.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := offset(content, change.Range.End)
+	if err != nil {
+		return nil, err
+	}
+	if end < start {
+		return nil, fmt.Errorf("invalid range %v", *change.Range)
+	}
+	var buf bytes.Buffer
+	buf.Write(content[:start])
+	buf.WriteString(change.Text)
+	buf.Write(content[end:])
+	return buf.Bytes(), nil
+}
+
+// A query is a query of the daemon at the position of a request.
+type query struct {
+	client Client
+	pos    string            // in guru syntax
+	docs   map[string][]byte // snapshot of the open documents
+	opts   []god.Option
+}
+
+// newQuery returns the query at the position of params.
+// s.mu must be held.
+func (s *Server) newQuery(params *TextDocumentPositionParams) (*query, error) {
+	filename, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	q := &query{
+		client: s.client,
+		docs:   make(map[string][]byte, len(s.docs)),
+	}
+	// Only documents with unsaved changes need an overlay; the
+	// daemon answers other queries from its index sooner.
+	overlay := make(map[string][]byte)
+	for name, doc := range s.docs {
+		q.docs[name] = doc.content
+		if doc.dirty {
+			overlay[name] = doc.content
+		}
+	}
+	content, err := q.content(filename)
+	if err != nil {
+		return nil, err
+	}
+	off, err := offset(content, params.Position)
+	if err != nil {
+		return nil, err
+	}
+	q.pos = fmt.Sprintf("%s:#%d", filename, off)
+	q.opts = append(q.opts, s.opts...)
+	if len(overlay) > 0 {
+		q.opts = append(q.opts, god.WithOverlay(overlay))
+	}
+	return q, nil
+}
+
+// content returns the content of the named file seen by q.
+func (q *query) content(filename string) ([]byte, error) {
+	if content, ok := q.docs[filename]; ok {
+		return content, nil
+	}
+	return ioutil.ReadFile(filename)
+}
+
+// location returns the Location of the guru position pos, and false if
+// pos does not denote a position in a file.
+func (q *query) location(pos string) (Location, bool) {
+	filename, line, col, ok := god.SplitPosition(pos)
+	if !ok {
+		return Location{}, false
+	}
+	content, err := q.content(filename)
+	if err != nil {
+		return Location{}, false
+	}
+	return Location{
+		URI:   pathToURI(filename),
+		Range: identRange(content, line, col),
+	}, true
+}
+
+func (q *query) definition(ctx context.Context) (interface{}, error) {
+	def, err := q.client.Definition(ctx, q.pos, q.opts...)
+	if err != nil {
+		return nil, err
+	}
+	locs := []Location{}
+	if loc, ok := q.location(def.ObjPos); ok {
+		locs = append(locs, loc)
+	}
+	return locs, nil
+}
+
+func (q *query) references(ctx context.Context, includeDeclaration bool) (interface{}, error) {
+	locs := []Location{}
+	var mu sync.Mutex
+	err := q.client.StreamReferrers(ctx, q.pos, func(event *serialpb.ReferrersEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		if event.Initial != nil && includeDeclaration {
+			if loc, ok := q.location(event.Initial.ObjPos); ok {
+				locs = append(locs, loc)
+			}
+		}
+		if event.Package != nil {
+			for _, ref := range event.Package.Refs {
+				if loc, ok := q.location(ref.Pos); ok {
+					locs = append(locs, loc)
+				}
+			}
+		}
+	}, q.opts...)
+	if err != nil {
+		return nil, err
+	}
+	return locs, nil
+}
+
+// hover returns a description of the selected syntax, e.g. "x int" for
+// a variable x, or nil if there is nothing to describe.
+func (q *query) hover(ctx context.Context) (interface{}, error) {
+	desc, err := q.client.Describe(ctx, q.pos, q.opts...)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		return nil, nil // e.g. no identifier here
+	}
+	var text string
+	switch {
+	case desc.Value != nil && desc.Value.ObjPos != "":
+		name := q.ident(desc.Pos)
+		if name == "" {
+			return nil, nil
+		}
+		text = name + " " + desc.Value.Type
+		if desc.Value.Value != "" {
+			text += " = " + desc.Value.Value
+		}
+	case desc.Value != nil && desc.Value.Value != "":
+		text = desc.Desc + " of value " + desc.Value.Value
+	case desc.Value != nil:
+		text = desc.Desc + " of type " + desc.Value.Type
+	case desc.Type != nil && desc.Type.NamePos != "":
+		text = "type " + desc.Type.Type + " " + desc.Type.NameDef
+	case desc.Type != nil:
+		text = desc.Type.Type
+	case desc.Package != nil:
+		text = "package " + desc.Package.Path
+	default:
+		text = desc.Desc
+	}
+	if text == "" {
+		return nil, nil
+	}
+	return &Hover{Contents: MarkupContent{Kind: "plaintext", Value: text}}, nil
+}
+
+// ident returns the identifier at the guru position pos, or "".
+func (q *query) ident(pos string) string {
+	filename, line, col, ok := god.SplitPosition(pos)
+	if !ok {
+		return ""
+	}
+	content, err := q.content(filename)
+	if err != nil {
+		return ""
+	}
+	return identAt(content, line, col)
+}
+
+// implementation returns the concrete types that implement the selected
+// interface type, or the interfaces that the selected type implements,
+// or the corresponding methods if a method is selected.
+func (q *query) implementation(ctx context.Context) (interface{}, error) {
+	impl, err := q.client.Implements(ctx, q.pos, q.opts...)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		types   []serialpb.ImplementsType
+		methods []serialpb.DescribeMethod
+	)
+	if impl.T.Kind == "interface" {
+		types = impl.AssignableTo
+		methods = impl.AssignableToMethod
+	} else {
+		types = append(impl.AssignableFrom, impl.AssignableFromPtr...)
+		methods = append(impl.AssignableFromMethod, impl.AssignableFromPtrMethod...)
+	}
+
+	locs := []Location{}
+	if impl.Method != nil {
+		for _, m := range methods {
+			if loc, ok := q.location(m.Pos); ok {
+				locs = append(locs, loc)
+			}
+		}
+		return locs, nil
+	}
+	for _, t := range types {
+		if loc, ok := q.location(t.Pos); ok {
+			locs = append(locs, loc)
+		}
+	}
+	return locs, nil
+}
+
+// documentHighlight returns the identifiers of the document that refer
+// to the same object as the selected one.
+func (q *query) documentHighlight(ctx context.Context) (interface{}, error) {
+	what, err := q.client.What(ctx, q.pos, q.opts...)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+	filename := q.pos[:strings.LastIndex(q.pos, ":#")]
+	highlights := []DocumentHighlight{}
+	for _, pos := range what.SameIDs {
+		if name, _, _, ok := god.SplitPosition(pos); !ok || name != filename {
+			continue
+		}
+		if loc, ok := q.location(pos); ok {
+			highlights = append(highlights, DocumentHighlight{Range: loc.Range, Kind: 1})
+		}
+	}
+	return highlights, nil
+}
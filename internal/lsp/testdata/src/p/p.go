@@ -0,0 +1,17 @@
+package p
+
+type I interface {
+	M()
+}
+
+type T struct{}
+
+func (T) M() {}
+
+var s, t = "😀", s
+
+func f() {
+	var i I = T{}
+	i.M()
+	_ = t
+}
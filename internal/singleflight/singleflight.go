@@ -0,0 +1,66 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package singleflight coalesces identical calls in progress into one,
+// whose result every caller shares, in the manner of
+// golang.org/x/sync/singleflight.
+package singleflight
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrPanicked is the error of the callers that wait for a call which
+// panics.  The caller that made the call panics instead.
+var ErrPanicked = errors.New("singleflight: the call panicked")
+
+// A Group coalesces the calls of the same key.  The zero Group is ready
+// to use.
+type Group struct {
+	mu        sync.Mutex
+	calls     map[string]*call // in progress
+	coalesced int64            // atomic
+}
+
+type call struct {
+	done chan struct{}
+	val  interface{}
+	err  error
+}
+
+// Do calls fn and returns its results, unless a call of key is already
+// in progress; Do then waits for it, and returns its results with
+// coalesced set.  The results are shared, so they must not be modified.
+func (g *Group) Do(key string, fn func() (interface{}, error)) (v interface{}, err error, coalesced bool) {
+	g.mu.Lock()
+	if c, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		atomic.AddInt64(&g.coalesced, 1)
+		<-c.done
+		return c.val, c.err, true
+	}
+	if g.calls == nil {
+		g.calls = make(map[string]*call)
+	}
+	c := &call{done: make(chan struct{}), err: ErrPanicked}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(c.done)
+	}()
+	c.val, c.err = fn()
+	return c.val, c.err, false
+}
+
+// Coalesced returns the number of calls of g that waited for another
+// one instead of calling their function.
+func (g *Group) Coalesced() int64 {
+	return atomic.LoadInt64(&g.coalesced)
+}
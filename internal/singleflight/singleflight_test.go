@@ -0,0 +1,100 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package singleflight
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDo(t *testing.T) {
+	var g Group
+	v, err, coalesced := g.Do("key", func() (interface{}, error) { return "v", nil })
+	if v != "v" || err != nil || coalesced {
+		t.Errorf("Do = %v, %v, %t, want v, nil, false", v, err, coalesced)
+	}
+	want := errors.New("error")
+	if _, err, _ := g.Do("key", func() (interface{}, error) { return nil, want }); err != want {
+		t.Errorf("Do: got error %v, want %v", err, want)
+	}
+	if n := g.Coalesced(); n != 0 {
+		t.Errorf("got %d coalesced calls of successive calls, want 0", n)
+	}
+}
+
+func TestDoCoalesces(t *testing.T) {
+	var (
+		g       Group
+		calls   int32
+		release = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+	fn := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "v", nil
+	}
+	const n = 10
+	var ncoalesced int32
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err, coalesced := g.Do("key", fn)
+			if v != "v" || err != nil {
+				t.Errorf("Do = %v, %v, want v, nil", v, err)
+			}
+			if coalesced {
+				atomic.AddInt32(&ncoalesced, 1)
+			}
+		}()
+	}
+	for g.Coalesced() < n-1 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	if calls != 1 || ncoalesced != n-1 {
+		t.Errorf("got %d calls and %d coalesced callers, want 1 and %d", calls, ncoalesced, n-1)
+	}
+
+	// Other keys are not coalesced.
+	if _, _, coalesced := g.Do("other", fn); coalesced {
+		t.Errorf("Do of another key: got a coalesced call")
+	}
+}
+
+func TestDoPanic(t *testing.T) {
+	var g Group
+	started, release := make(chan struct{}), make(chan struct{})
+	errc := make(chan error)
+	go func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Do of a panicking call did not panic")
+			}
+		}()
+		g.Do("key", func() (interface{}, error) {
+			close(started)
+			<-release
+			panic("bad call")
+		})
+	}()
+	<-started
+	go func() {
+		_, err, _ := g.Do("key", func() (interface{}, error) { return nil, nil })
+		errc <- err
+	}()
+	for g.Coalesced() < 1 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	if err := <-errc; err != ErrPanicked {
+		t.Errorf("waiting for a panicking call: got error %v, want %v", err, ErrPanicked)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package watcher
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// A fileVersion identifies the content of a source file.
+type fileVersion struct {
+	size    int64
+	modTime time.Time
+}
+
+// A poller is a notifier that periodically compares the size and
+// modification time of the source files of each directory.
+type poller struct {
+	changed chan<- string
+	done    chan struct{}
+
+	mu   sync.Mutex
+	dirs map[string]map[string]fileVersion // directory -> file name -> version
+}
+
+func newPoller(changed chan<- string, interval time.Duration) *poller {
+	p := &poller{
+		changed: changed,
+		done:    make(chan struct{}),
+		dirs:    make(map[string]map[string]fileVersion),
+	}
+	go p.loop(interval)
+	return p
+}
+
+func (p *poller) add(dir string) error {
+	files, err := scan(dir)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.dirs[dir] = files
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *poller) close() error {
+	close(p.done)
+	return nil
+}
+
+func (p *poller) loop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.poll()
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// poll rescans every directory and reports those whose source files
+// were added, removed or modified.
+func (p *poller) poll() {
+	p.mu.Lock()
+	dirs := make([]string, 0, len(p.dirs))
+	for dir := range p.dirs {
+		dirs = append(dirs, dir)
+	}
+	p.mu.Unlock()
+
+	for _, dir := range dirs {
+		files, _ := scan(dir) // a removed directory has no files
+		p.mu.Lock()
+		old := p.dirs[dir]
+		p.dirs[dir] = files
+		p.mu.Unlock()
+		if !sameFiles(old, files) {
+			select {
+			case p.changed <- dir:
+			case <-p.done:
+				return
+			}
+		}
+	}
+}
+
+// scan returns the versions of the source files in dir.
+func scan(dir string) (map[string]fileVersion, error) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]fileVersion)
+	for _, fi := range fis {
+		if fi.Mode().IsRegular() && isSource(fi.Name()) {
+			files[filepath.Join(dir, fi.Name())] = fileVersion{fi.Size(), fi.ModTime()}
+		}
+	}
+	return files, nil
+}
+
+func sameFiles(x, y map[string]fileVersion) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for name, v := range x {
+		if w, ok := y[name]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,177 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package watcher reports the packages whose Go source files change on
+// disk.
+//
+// A Watcher watches the directories of the packages it is given and,
+// when a file in one of them changes, reports that package only.  The
+// packages that import it need not be reported: guru.Cache.Invalidate
+// type-checks them again in every program that holds the package, and
+// the results of queries depend on every package of their program (see
+// guru.Versions.DependsOn), so those on importers are discarded too.
+package watcher
+
+import (
+	"go/build"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zchee/god/internal/log"
+)
+
+// Latency is the time a Watcher waits after a change for further
+// changes, so that a batch of edits (e.g. a branch switch) results in a
+// single report.
+var Latency = 100 * time.Millisecond
+
+// PollInterval is the interval at which directories are scanned when
+// the operating system offers no file change notification.
+var PollInterval = time.Second
+
+// A notifier sends the names of changed directories to a Watcher.
+type notifier interface {
+	add(dir string) error
+	close() error
+}
+
+// A Watcher reports the packages whose files change.
+type Watcher struct {
+	ctxt     *build.Context
+	onChange func(pkgs []string)
+	notifier notifier
+	changed  chan string // directories with changed files
+	done     chan struct{}
+	closed   sync.Once
+
+	mu   sync.Mutex
+	dirs map[string]bool // watched directories
+}
+
+// New returns a Watcher for the packages of the workspace described by
+// ctxt.  It uses the operating system's file change notification if
+// available, or otherwise polls the watched directories.
+//
+// If onChange is non-nil, it is called with the sorted import paths of
+// each batch of changed packages.
+func New(ctxt *build.Context, onChange func(pkgs []string)) *Watcher {
+	w := newWatcher(ctxt, onChange)
+	n, err := newNotifier(w.changed)
+	if err != nil {
+		log.Debugf("watcher: %v; polling every %v", err, PollInterval)
+		n = newPoller(w.changed, PollInterval)
+	}
+	w.notifier = n
+	go w.loop()
+	return w
+}
+
+// NewPoller is like New but always polls the watched directories at
+// the given interval.
+func NewPoller(ctxt *build.Context, interval time.Duration, onChange func(pkgs []string)) *Watcher {
+	w := newWatcher(ctxt, onChange)
+	w.notifier = newPoller(w.changed, interval)
+	go w.loop()
+	return w
+}
+
+func newWatcher(ctxt *build.Context, onChange func(pkgs []string)) *Watcher {
+	return &Watcher{
+		ctxt:     ctxt,
+		onChange: onChange,
+		changed:  make(chan string, 64),
+		done:     make(chan struct{}),
+		dirs:     make(map[string]bool),
+	}
+}
+
+// Add starts watching the named package directories.
+// Directories already being watched are ignored.
+func (w *Watcher) Add(dirs ...string) error {
+	for _, dir := range dirs {
+		dir = filepath.Clean(dir)
+		w.mu.Lock()
+		seen := w.dirs[dir]
+		w.dirs[dir] = true
+		w.mu.Unlock()
+		if seen {
+			continue
+		}
+		if err := w.notifier.add(dir); err != nil {
+			w.mu.Lock()
+			delete(w.dirs, dir)
+			w.mu.Unlock()
+			return err
+		}
+	}
+	return nil
+}
+
+// Close stops watching.  Only the first call has any effect.
+func (w *Watcher) Close() error {
+	var err error
+	w.closed.Do(func() {
+		close(w.done)
+		err = w.notifier.close()
+	})
+	return err
+}
+
+// loop collects changed directories and reports each batch once no
+// further change arrives within Latency.
+func (w *Watcher) loop() {
+	pending := make(map[string]bool)
+	var timer <-chan time.Time
+	for {
+		select {
+		case dir := <-w.changed:
+			pending[dir] = true
+			timer = time.After(Latency)
+		case <-timer:
+			w.report(pending)
+			pending = make(map[string]bool)
+			timer = nil
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// report reports the packages in dirs to the onChange function.
+func (w *Watcher) report(dirs map[string]bool) {
+	var pkgs []string
+	for dir := range dirs {
+		bp, err := w.ctxt.ImportDir(dir, build.FindOnly)
+		if err != nil || bp.ImportPath == "" || bp.ImportPath == "." {
+			continue // not in the workspace
+		}
+		pkgs = append(pkgs, bp.ImportPath)
+	}
+	if pkgs == nil {
+		return
+	}
+	sort.Strings(pkgs)
+	log.Debugf("watcher: changed %v", pkgs)
+
+	if w.onChange != nil {
+		w.onChange(pkgs)
+	}
+}
+
+// isSource reports whether a change to the named file may affect a
+// package.
+func isSource(name string) bool {
+	base := filepath.Base(name)
+	if strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_") {
+		return false // editor temporaries, ignored files
+	}
+	switch filepath.Ext(base) {
+	case ".go", ".c", ".h", ".s", ".S", ".cc", ".cpp", ".cxx", ".hh", ".hpp", ".hxx", ".m", ".swig", ".swigcxx", ".syso":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package watcher
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// inotifyMask selects the events that may change the set or content of
+// the source files of a directory.
+const inotifyMask = syscall.IN_CLOSE_WRITE | syscall.IN_MODIFY | syscall.IN_ATTRIB |
+	syscall.IN_CREATE | syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO |
+	syscall.IN_DELETE_SELF | syscall.IN_MOVE_SELF | syscall.IN_ONLYDIR
+
+// An inotify is a notifier using the Linux inotify API.
+type inotify struct {
+	fd      int
+	file    *os.File // fd, registered with the runtime poller so that Close interrupts Read
+	changed chan<- string
+	done    chan struct{}
+
+	mu   sync.Mutex
+	dirs map[int32]string // watch descriptor -> directory
+}
+
+func newNotifier(changed chan<- string) (notifier, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, os.NewSyscallError("inotify_init1", err)
+	}
+	n := &inotify{
+		fd:      fd,
+		file:    os.NewFile(uintptr(fd), "inotify"),
+		changed: changed,
+		done:    make(chan struct{}),
+		dirs:    make(map[int32]string),
+	}
+	go n.loop()
+	return n, nil
+}
+
+func (n *inotify) add(dir string) error {
+	wd, err := syscall.InotifyAddWatch(n.fd, dir, inotifyMask)
+	if err != nil {
+		return &os.PathError{Op: "inotify_add_watch", Path: dir, Err: err}
+	}
+	n.mu.Lock()
+	n.dirs[int32(wd)] = dir
+	n.mu.Unlock()
+	return nil
+}
+
+func (n *inotify) close() error {
+	close(n.done)
+	return n.file.Close()
+}
+
+// loop reads inotify events until the file is closed and reports the
+// directory of each changed source file.
+func (n *inotify) loop() {
+	buf := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+	for {
+		nr, err := n.file.Read(buf)
+		if err != nil {
+			return // closed
+		}
+		for off := 0; off+syscall.SizeofInotifyEvent <= nr; {
+			ev := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[off]))
+			name := buf[off+syscall.SizeofInotifyEvent : off+syscall.SizeofInotifyEvent+int(ev.Len)]
+			off += syscall.SizeofInotifyEvent + int(ev.Len)
+
+			n.mu.Lock()
+			dir, ok := n.dirs[ev.Wd]
+			if ev.Mask&syscall.IN_IGNORED != 0 {
+				delete(n.dirs, ev.Wd) // watch removed, e.g. directory deleted
+			}
+			n.mu.Unlock()
+			if !ok {
+				continue
+			}
+
+			if ev.Mask&(syscall.IN_DELETE_SELF|syscall.IN_MOVE_SELF) == 0 {
+				if i := bytes.IndexByte(name, 0); i >= 0 {
+					name = name[:i]
+				}
+				if !isSource(string(name)) {
+					continue
+				}
+			}
+			select {
+			case n.changed <- filepath.Clean(dir):
+			case <-n.done:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !linux
+
+package watcher
+
+import "errors"
+
+func newNotifier(changed chan<- string) (notifier, error) {
+	return nil, errors.New("file change notification not supported")
+}
@@ -0,0 +1,101 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package watcher
+
+import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// workspace creates a temporary GOPATH holding the packages a, b
+// (which imports a), c (which imports b) and d (independent).
+func workspace(t *testing.T) (gopath string) {
+	gopath, err := ioutil.TempDir("", "god-watcher")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"a/a.go": "package a\n",
+		"b/b.go": "package b\n\nimport _ \"a\"\n",
+		"c/c.go": "package c\n\nimport _ \"b\"\n",
+		"d/d.go": "package d\n",
+	}
+	for name, content := range files {
+		name = filepath.Join(gopath, "src", name)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return gopath
+}
+
+func testWatcher(t *testing.T, newWatcher func(*build.Context, func([]string)) *Watcher) {
+	gopath := workspace(t)
+	defer os.RemoveAll(gopath)
+
+	ctxt := build.Default
+	ctxt.GOPATH = gopath
+
+	events := make(chan []string, 10)
+	w := newWatcher(&ctxt, func(pkgs []string) { events <- pkgs })
+	defer w.Close()
+	for _, pkg := range []string{"a", "b", "c", "d"} {
+		if err := w.Add(filepath.Join(gopath, "src", pkg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string // file to write
+		content string
+		want    []string
+	}{
+		{"a/a.go", "package a\n\nvar X int\n", []string{"a"}}, // not its importers b and c
+		{"c/c2.go", "package c\n", []string{"c"}},
+		{"d/d.go", "package d\n\nvar Y int\n", []string{"d"}},
+	}
+	for _, test := range tests {
+		name := filepath.Join(gopath, "src", test.name)
+		if err := ioutil.WriteFile(name, []byte(test.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case got := <-events:
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("write %s: got changed %v, want %v", test.name, got, test.want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("write %s: no change reported", test.name)
+		}
+	}
+
+	// Files other than sources are ignored.
+	if err := ioutil.WriteFile(filepath.Join(gopath, "src", "d", ".d.go.swp"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-events:
+		t.Errorf("write .d.go.swp: got changed %v, want none", got)
+	case <-time.After(3 * Latency):
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	testWatcher(t, New)
+}
+
+func TestPoller(t *testing.T) {
+	testWatcher(t, func(ctxt *build.Context, onChange func([]string)) *Watcher {
+		return NewPoller(ctxt, 10*time.Millisecond, onChange)
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrRunning is returned by Server.Start if another god daemon already
+// serves the same address.
+var ErrRunning = errors.New("god daemon is already running")
+
+// PIDFile returns the name of the file that holds the process ID of the
+// god daemon at addr, as resolved by ResolveAddress: next to its Unix
+// socket, or in os.TempDir for a TCP address.
+func PIDFile(addr string) string {
+	network, address := ResolveAddress(addr)
+	if network == "unix" {
+		return address + ".pid"
+	}
+	name := strings.NewReplacer(":", "_", "[", "", "]", "").Replace(address)
+	return filepath.Join(os.TempDir(), fmt.Sprintf("god-%s.pid", name))
+}
+
+// ReadPID returns the process ID of the god daemon at addr.
+func ReadPID(addr string) (int, error) {
+	b, err := ioutil.ReadFile(PIDFile(addr))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(b)))
+}
+
+// A pidLock is the lock that a god daemon holds on its PID file.
+type pidLock struct {
+	f *os.File
+}
+
+// lockPID locks the PID file of the daemon at addr and writes the ID
+// of the current process to it.  It returns ErrRunning if another
+// process holds the lock.
+func lockPID(addr string) (*pidLock, error) {
+	name := PIDFile(addr)
+	if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+		return nil, err
+	}
+	for {
+		f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0600)
+		if err != nil {
+			return nil, err
+		}
+		if err := lockFile(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+
+		// The previous owner may have removed the file between
+		// our open and lock; then lock the new file instead.
+		fi, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		if cur, err := os.Stat(name); err != nil || !os.SameFile(fi, cur) {
+			f.Close()
+			continue
+		}
+
+		if err := f.Truncate(0); err != nil {
+			f.Close()
+			return nil, err
+		}
+		if _, err := fmt.Fprintf(f, "%d\n", os.Getpid()); err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &pidLock{f: f}, nil
+	}
+}
+
+// release removes the PID file and releases the lock.
+func (l *pidLock) release() error {
+	err := os.Remove(l.f.Name())
+	if cerr := l.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,22 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !windows
+
+package god
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive lock on f, which is released when f is
+// closed, e.g. by the exit of the process.
+func lockFile(f *os.File) error {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err == syscall.EWOULDBLOCK {
+		return ErrRunning
+	}
+	return err
+}
@@ -0,0 +1,13 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import "os"
+
+// lockFile does nothing: on Windows the daemon listens on a TCP port,
+// which only one process can bind.
+func lockFile(f *os.File) error {
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/zchee/god/internal/guru"
+
+	"golang.org/x/net/context"
+)
+
+// maxResults is the number of responses a resultCache retains before it
+// evicts the least recently used one.
+const maxResults = 1024
+
+// A message is a response of the server that can be cached: one with a
+// Cached field, set when it is answered from the cache.
+type message interface {
+	Marshal() ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// A resultCache holds the serialized responses of the queries of a
+// workspace, keyed by their requests, so that a query that repeats
+// exactly is answered without running it again until a file that it
+// depends on changes.
+type resultCache struct {
+	mu      sync.Mutex
+	entries map[string]*result
+	hits    int64
+	misses  int64
+}
+
+// A result is the response to a query, made of one message or, for a
+// streaming query, of any number of messages.
+type result struct {
+	typ      reflect.Type // of the messages
+	data     [][]byte     // the serialized messages
+	versions *guru.Versions
+	used     time.Time // guarded by resultCache.mu
+}
+
+func newResultCache() *resultCache {
+	return &resultCache{entries: make(map[string]*result)}
+}
+
+// get returns new copies of the messages cached under key, marked as
+// cached, unless a file that they depend on changed since.
+func (c *resultCache) get(key string) ([]message, bool) {
+	c.mu.Lock()
+	r := c.entries[key]
+	c.mu.Unlock()
+	if r == nil {
+		c.count(false)
+		return nil, false
+	}
+	if r.versions.Changed() {
+		c.mu.Lock()
+		if c.entries[key] == r {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+		c.count(false)
+		return nil, false
+	}
+
+	msgs := make([]message, len(r.data))
+	for i, data := range r.data {
+		v := reflect.New(r.typ.Elem())
+		msgs[i] = v.Interface().(message)
+		if err := msgs[i].Unmarshal(data); err != nil {
+			c.count(false)
+			return nil, false
+		}
+		v.Elem().FieldByName("Cached").SetBool(true)
+	}
+	c.mu.Lock()
+	r.used = time.Now()
+	c.mu.Unlock()
+	c.count(true)
+	return msgs, true
+}
+
+// count counts a hit or a miss.
+func (c *resultCache) count(hit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if hit {
+		c.hits++
+	} else {
+		c.misses++
+	}
+}
+
+// put caches msgs, of type typ, under key if versions hold all the files
+// that they depend on.
+func (c *resultCache) put(key string, typ reflect.Type, msgs []message, versions *guru.Versions) {
+	if !versions.Complete() {
+		return
+	}
+	r := &result{
+		typ:      typ,
+		data:     make([][]byte, len(msgs)),
+		versions: versions,
+		used:     time.Now(),
+	}
+	for i, m := range msgs {
+		data, err := m.Marshal()
+		if err != nil {
+			return
+		}
+		r.data[i] = data
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = r
+	for len(c.entries) > maxResults {
+		var oldest string
+		var t time.Time
+		for key, r := range c.entries {
+			if oldest == "" || r.used.Before(t) {
+				oldest, t = key, r.used
+			}
+		}
+		delete(c.entries, oldest)
+	}
+}
+
+// invalidate discards the responses that depend on the packages with
+// the specified import paths, as reported by the watcher.
+func (c *resultCache) invalidate(paths []string) {
+	changed := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		changed[path] = true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, r := range c.entries {
+		if r.versions.DependsOn(changed) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// stats returns the number of hits and misses of c.
+func (c *resultCache) stats() (hits, misses int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
+
+type versionsKey struct{}
+
+// withVersions returns a copy of ctx in which the queries of the server
+// record the versions of the files they read in versions.
+func withVersions(ctx context.Context, versions *guru.Versions) context.Context {
+	return context.WithValue(ctx, versionsKey{}, versions)
+}
+
+// versionsFrom returns the versions recorded by the queries of ctx, or
+// nil.
+func versionsFrom(ctx context.Context) *guru.Versions {
+	versions, _ := ctx.Value(versionsKey{}).(*guru.Versions)
+	return versions
+}
@@ -9,11 +9,13 @@
 
 	It has these top-level messages:
 		Location
+		Overlay
 		Options
 		Peers
 		ReferrersInitial
 		ReferrersPackage
 		Ref
+		ReferrersEvent
 		Definition
 		Callees
 		Callee
@@ -37,6 +39,17 @@
 		Describe
 		WhichErrs
 		WhichErrsType
+		Query
+		Output
+		BatchRequest
+		BatchItem
+		BatchResult
+		DaemonStatus
+		WorkspaceStatus
+		ProgramStatus
+		Counters
+		CallStatus
+		ErrorDetails
 		Request
 		Response
 */
@@ -66,12 +79,18 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // Location is the location of current cursor.
+// If filename is set, the cursor is at line and col of that file, both
+// 1-based, col in bytes.  Otherwise pos holds the position in the syntax
+// of guru, e.g. "file.go:#123,#456" or "file.go:12:3,12:9".
 type Location struct {
 	Filename string   `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
 	Line     int64    `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
 	Col      int64    `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
 	Pos      string   `protobuf:"bytes,4,opt,name=pos,proto3" json:"pos,omitempty"`
 	Options  *Options `protobuf:"bytes,5,opt,name=options" json:"options,omitempty"`
+	// overlays are the contents of unsaved editor buffers, which take
+	// precedence over the files on disk.
+	Overlays []Overlay `protobuf:"bytes,6,rep,name=overlays" json:"overlays"`
 }
 
 func (m *Location) Reset()                    { *m = Location{} }
@@ -79,14 +98,37 @@ func (m *Location) String() string            { return proto.CompactTextString(m
 func (*Location) ProtoMessage()               {}
 func (*Location) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{0} }
 
+// Overlay is the content of a modified file.
+type Overlay struct {
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Content  []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *Overlay) Reset()                    { *m = Overlay{} }
+func (m *Overlay) String() string            { return proto.CompactTextString(m) }
+func (*Overlay) ProtoMessage()               {}
+func (*Overlay) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{1} }
+
+// Options holds the options of a query.
+// The query uses the build context of the daemon, of which each non-empty
+// build context field replaces the corresponding one.  CgoEnabled replaces
+// that of the daemon only if CgoEnabledSet, so that false can be requested.
 type Options struct {
-	Scope string `protobuf:"bytes,1,opt,name=Scope,proto3" json:"Scope,omitempty"`
+	Scope         string   `protobuf:"bytes,1,opt,name=Scope,proto3" json:"Scope,omitempty"`
+	GOROOT        string   `protobuf:"bytes,2,opt,name=GOROOT,proto3" json:"GOROOT,omitempty"`
+	GOPATH        string   `protobuf:"bytes,3,opt,name=GOPATH,proto3" json:"GOPATH,omitempty"`
+	GOOS          string   `protobuf:"bytes,4,opt,name=GOOS,proto3" json:"GOOS,omitempty"`
+	GOARCH        string   `protobuf:"bytes,5,opt,name=GOARCH,proto3" json:"GOARCH,omitempty"`
+	BuildTags     []string `protobuf:"bytes,6,rep,name=BuildTags" json:"BuildTags,omitempty"`
+	CgoEnabled    bool     `protobuf:"varint,7,opt,name=CgoEnabled,proto3" json:"CgoEnabled,omitempty"`
+	Reflection    bool     `protobuf:"varint,8,opt,name=Reflection,proto3" json:"Reflection,omitempty"`
+	CgoEnabledSet bool     `protobuf:"varint,9,opt,name=CgoEnabledSet,proto3" json:"CgoEnabledSet,omitempty"`
 }
 
 func (m *Options) Reset()                    { *m = Options{} }
 func (m *Options) String() string            { return proto.CompactTextString(m) }
 func (*Options) ProtoMessage()               {}
-func (*Options) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{1} }
+func (*Options) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{2} }
 
 // Peers is the result of a 'peers' query.
 // If Allocs is empty, the selected channel can't point to anything.
@@ -97,12 +139,13 @@ type Peers struct {
 	Sends    []string `protobuf:"bytes,4,rep,name=Sends" json:"Sends,omitempty"`
 	Receives []string `protobuf:"bytes,5,rep,name=Receives" json:"Receives,omitempty"`
 	Closes   []string `protobuf:"bytes,6,rep,name=Closes" json:"Closes,omitempty"`
+	Cached   bool     `protobuf:"varint,7,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *Peers) Reset()                    { *m = Peers{} }
 func (m *Peers) String() string            { return proto.CompactTextString(m) }
 func (*Peers) ProtoMessage()               {}
-func (*Peers) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{2} }
+func (*Peers) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{3} }
 
 // A "referrers" query emits a ReferrersInitial object followed by zero or
 // more ReferrersPackage objects, one per package that contains a reference.
@@ -114,17 +157,18 @@ type ReferrersInitial struct {
 func (m *ReferrersInitial) Reset()                    { *m = ReferrersInitial{} }
 func (m *ReferrersInitial) String() string            { return proto.CompactTextString(m) }
 func (*ReferrersInitial) ProtoMessage()               {}
-func (*ReferrersInitial) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{3} }
+func (*ReferrersInitial) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{4} }
 
 type ReferrersPackage struct {
 	Package string `protobuf:"bytes,1,opt,name=Package,proto3" json:"Package,omitempty"`
 	Refs    []Ref  `protobuf:"bytes,2,rep,name=Refs" json:"Refs"`
+	Cached  bool   `protobuf:"varint,3,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *ReferrersPackage) Reset()                    { *m = ReferrersPackage{} }
 func (m *ReferrersPackage) String() string            { return proto.CompactTextString(m) }
 func (*ReferrersPackage) ProtoMessage()               {}
-func (*ReferrersPackage) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{4} }
+func (*ReferrersPackage) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{5} }
 
 type Ref struct {
 	Pos  string `protobuf:"bytes,1,opt,name=Pos,proto3" json:"Pos,omitempty"`
@@ -134,30 +178,46 @@ type Ref struct {
 func (m *Ref) Reset()                    { *m = Ref{} }
 func (m *Ref) String() string            { return proto.CompactTextString(m) }
 func (*Ref) ProtoMessage()               {}
-func (*Ref) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{5} }
+func (*Ref) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{6} }
+
+// ReferrersEvent is an item of the stream of a 'referrers' query:
+// exactly one of its fields is set.  The first event of the stream
+// holds the ReferrersInitial object; each later event holds a package,
+// sent as soon as its references are found.
+type ReferrersEvent struct {
+	Initial *ReferrersInitial `protobuf:"bytes,1,opt,name=Initial" json:"Initial,omitempty"`
+	Package *ReferrersPackage `protobuf:"bytes,2,opt,name=Package" json:"Package,omitempty"`
+}
+
+func (m *ReferrersEvent) Reset()                    { *m = ReferrersEvent{} }
+func (m *ReferrersEvent) String() string            { return proto.CompactTextString(m) }
+func (*ReferrersEvent) ProtoMessage()               {}
+func (*ReferrersEvent) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{7} }
 
 // Definition is the result of a 'definition' query.
 type Definition struct {
 	ObjPos string `protobuf:"bytes,1,opt,name=ObjPos,proto3" json:"ObjPos,omitempty"`
 	Desc   string `protobuf:"bytes,2,opt,name=Desc,proto3" json:"Desc,omitempty"`
+	Cached bool   `protobuf:"varint,3,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *Definition) Reset()                    { *m = Definition{} }
 func (m *Definition) String() string            { return proto.CompactTextString(m) }
 func (*Definition) ProtoMessage()               {}
-func (*Definition) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{6} }
+func (*Definition) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{8} }
 
 // Callees is the result of a 'callees' query.
 type Callees struct {
 	Pos     string    `protobuf:"bytes,1,opt,name=Pos,proto3" json:"Pos,omitempty"`
 	Desc    string    `protobuf:"bytes,2,opt,name=Desc,proto3" json:"Desc,omitempty"`
 	Callees []*Callee `protobuf:"bytes,3,rep,name=Callees" json:"Callees,omitempty"`
+	Cached  bool      `protobuf:"varint,4,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *Callees) Reset()                    { *m = Callees{} }
 func (m *Callees) String() string            { return proto.CompactTextString(m) }
 func (*Callees) ProtoMessage()               {}
-func (*Callees) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{7} }
+func (*Callees) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{9} }
 
 // Callees is nonempty unless the call was a dynamic call on a
 // provably nil func or interface value.
@@ -169,17 +229,18 @@ type Callee struct {
 func (m *Callee) Reset()                    { *m = Callee{} }
 func (m *Callee) String() string            { return proto.CompactTextString(m) }
 func (*Callee) ProtoMessage()               {}
-func (*Callee) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{8} }
+func (*Callee) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{10} }
 
 // Callers is slice of Caller.
 type Callers struct {
 	Callers []*Caller `protobuf:"bytes,1,rep,name=Callers" json:"Callers,omitempty"`
+	Cached  bool      `protobuf:"varint,2,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *Callers) Reset()                    { *m = Callers{} }
 func (m *Callers) String() string            { return proto.CompactTextString(m) }
 func (*Callers) ProtoMessage()               {}
-func (*Callers) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{9} }
+func (*Callers) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{11} }
 
 // Caller is one element of the slice returned by a 'callers' query.
 // (Callstack also contains a similar slice.)
@@ -194,7 +255,7 @@ type Caller struct {
 func (m *Caller) Reset()                    { *m = Caller{} }
 func (m *Caller) String() string            { return proto.CompactTextString(m) }
 func (*Caller) ProtoMessage()               {}
-func (*Caller) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{10} }
+func (*Caller) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{12} }
 
 // CallStack is the result of a 'callstack' query.
 // It indicates an arbitrary path from the root of the callgraph to
@@ -206,22 +267,24 @@ type CallStack struct {
 	Pos     string   `protobuf:"bytes,1,opt,name=Pos,proto3" json:"Pos,omitempty"`
 	Target  string   `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
 	Callers []Caller `protobuf:"bytes,3,rep,name=Callers" json:"Callers"`
+	Cached  bool     `protobuf:"varint,4,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *CallStack) Reset()                    { *m = CallStack{} }
 func (m *CallStack) String() string            { return proto.CompactTextString(m) }
 func (*CallStack) ProtoMessage()               {}
-func (*CallStack) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{11} }
+func (*CallStack) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{13} }
 
 // FreeVars is the slice of FreeVar.
 type FreeVars struct {
 	FreeVar []FreeVar `protobuf:"bytes,1,rep,name=FreeVar" json:"FreeVar"`
+	Cached  bool      `protobuf:"varint,2,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *FreeVars) Reset()                    { *m = FreeVars{} }
 func (m *FreeVars) String() string            { return proto.CompactTextString(m) }
 func (*FreeVars) ProtoMessage()               {}
-func (*FreeVars) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{12} }
+func (*FreeVars) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{14} }
 
 // FreeVar is one element of the slice returned by a 'freevars'
 // query.  Each one identifies an expression referencing a local
@@ -236,7 +299,7 @@ type FreeVar struct {
 func (m *FreeVar) Reset()                    { *m = FreeVar{} }
 func (m *FreeVar) String() string            { return proto.CompactTextString(m) }
 func (*FreeVar) ProtoMessage()               {}
-func (*FreeVar) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{13} }
+func (*FreeVar) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{15} }
 
 // Implements contains the result of an 'implements' query.
 // It describes the queried type, the set of named non-empty interface
@@ -256,12 +319,13 @@ type Implements struct {
 	AssignableToMethod      []DescribeMethod `protobuf:"bytes,6,rep,name=AssignableToMethod" json:"AssignableToMethod"`
 	AssignableFromMethod    []DescribeMethod `protobuf:"bytes,7,rep,name=AssignableFromMethod" json:"AssignableFromMethod"`
 	AssignableFromPtrMethod []DescribeMethod `protobuf:"bytes,8,rep,name=AssignableFromPtrMethod" json:"AssignableFromPtrMethod"`
+	Cached                  bool             `protobuf:"varint,9,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *Implements) Reset()                    { *m = Implements{} }
 func (m *Implements) String() string            { return proto.CompactTextString(m) }
 func (*Implements) ProtoMessage()               {}
-func (*Implements) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{14} }
+func (*Implements) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{16} }
 
 // ImplementsType describes a single type as part of an 'implements' query.
 type ImplementsType struct {
@@ -273,7 +337,7 @@ type ImplementsType struct {
 func (m *ImplementsType) Reset()                    { *m = ImplementsType{} }
 func (m *ImplementsType) String() string            { return proto.CompactTextString(m) }
 func (*ImplementsType) ProtoMessage()               {}
-func (*ImplementsType) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{15} }
+func (*ImplementsType) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{17} }
 
 // SyntaxNode is one element of a stack of enclosing syntax nodes in
 // a "what" query.
@@ -286,7 +350,7 @@ type SyntaxNode struct {
 func (m *SyntaxNode) Reset()                    { *m = SyntaxNode{} }
 func (m *SyntaxNode) String() string            { return proto.CompactTextString(m) }
 func (*SyntaxNode) ProtoMessage()               {}
-func (*SyntaxNode) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{16} }
+func (*SyntaxNode) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{18} }
 
 // What is the result of the "what" query, which quickly identifies
 // the selection, parsing only a single file.  It is intended for use
@@ -298,12 +362,13 @@ type What struct {
 	ImportPath string       `protobuf:"bytes,4,opt,name=ImportPath,proto3" json:"ImportPath,omitempty"`
 	Object     string       `protobuf:"bytes,5,opt,name=Object,proto3" json:"Object,omitempty"`
 	SameIDs    []string     `protobuf:"bytes,6,rep,name=SameIDs" json:"SameIDs,omitempty"`
+	Cached     bool         `protobuf:"varint,7,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *What) Reset()                    { *m = What{} }
 func (m *What) String() string            { return proto.CompactTextString(m) }
 func (*What) ProtoMessage()               {}
-func (*What) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{17} }
+func (*What) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{19} }
 
 // PointsToLabel describes a pointer analysis label.
 //
@@ -326,16 +391,17 @@ type PointsToLabel struct {
 func (m *PointsToLabel) Reset()                    { *m = PointsToLabel{} }
 func (m *PointsToLabel) String() string            { return proto.CompactTextString(m) }
 func (*PointsToLabel) ProtoMessage()               {}
-func (*PointsToLabel) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{18} }
+func (*PointsToLabel) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{20} }
 
 type PointsTos struct {
 	PointsTos []PointsTo `protobuf:"bytes,1,rep,name=PointsTos" json:"PointsTos"`
+	Cached    bool       `protobuf:"varint,2,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *PointsTos) Reset()                    { *m = PointsTos{} }
 func (m *PointsTos) String() string            { return proto.CompactTextString(m) }
 func (*PointsTos) ProtoMessage()               {}
-func (*PointsTos) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{19} }
+func (*PointsTos) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{21} }
 
 // PointsTo is one element of the result of a 'pointsto' query on an
 // expression.  It describes a single pointer: its type and the set of
@@ -356,7 +422,7 @@ type PointsTo struct {
 func (m *PointsTo) Reset()                    { *m = PointsTo{} }
 func (m *PointsTo) String() string            { return proto.CompactTextString(m) }
 func (*PointsTo) ProtoMessage()               {}
-func (*PointsTo) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{20} }
+func (*PointsTo) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{22} }
 
 // DescribeValue is the additional result of a 'describe' query
 // if the selection indicates a value or expression.
@@ -369,7 +435,7 @@ type DescribeValue struct {
 func (m *DescribeValue) Reset()                    { *m = DescribeValue{} }
 func (m *DescribeValue) String() string            { return proto.CompactTextString(m) }
 func (*DescribeValue) ProtoMessage()               {}
-func (*DescribeValue) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{21} }
+func (*DescribeValue) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{23} }
 
 type DescribeMethod struct {
 	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -379,7 +445,7 @@ type DescribeMethod struct {
 func (m *DescribeMethod) Reset()                    { *m = DescribeMethod{} }
 func (m *DescribeMethod) String() string            { return proto.CompactTextString(m) }
 func (*DescribeMethod) ProtoMessage()               {}
-func (*DescribeMethod) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{22} }
+func (*DescribeMethod) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{24} }
 
 // DescribeType is the additional result of a 'describe' query
 // if the selection indicates a type.
@@ -393,7 +459,7 @@ type DescribeType struct {
 func (m *DescribeType) Reset()                    { *m = DescribeType{} }
 func (m *DescribeType) String() string            { return proto.CompactTextString(m) }
 func (*DescribeType) ProtoMessage()               {}
-func (*DescribeType) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{23} }
+func (*DescribeType) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{25} }
 
 type DescribeMember struct {
 	Name    string           `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -407,7 +473,7 @@ type DescribeMember struct {
 func (m *DescribeMember) Reset()                    { *m = DescribeMember{} }
 func (m *DescribeMember) String() string            { return proto.CompactTextString(m) }
 func (*DescribeMember) ProtoMessage()               {}
-func (*DescribeMember) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{24} }
+func (*DescribeMember) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{26} }
 
 // DescribePackage is the additional result of a 'describe' if
 // the selection indicates a package.
@@ -419,7 +485,7 @@ type DescribePackage struct {
 func (m *DescribePackage) Reset()                    { *m = DescribePackage{} }
 func (m *DescribePackage) String() string            { return proto.CompactTextString(m) }
 func (*DescribePackage) ProtoMessage()               {}
-func (*DescribePackage) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{25} }
+func (*DescribePackage) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{27} }
 
 // Describe is the result of a 'describe' query.
 // It may contain an element describing the selected semantic entity
@@ -433,12 +499,13 @@ type Describe struct {
 	Package *DescribePackage `protobuf:"bytes,4,opt,name=Package" json:"Package,omitempty"`
 	Type    *DescribeType    `protobuf:"bytes,5,opt,name=Type" json:"Type,omitempty"`
 	Value   *DescribeValue   `protobuf:"bytes,6,opt,name=Value" json:"Value,omitempty"`
+	Cached  bool             `protobuf:"varint,7,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *Describe) Reset()                    { *m = Describe{} }
 func (m *Describe) String() string            { return proto.CompactTextString(m) }
 func (*Describe) ProtoMessage()               {}
-func (*Describe) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{26} }
+func (*Describe) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{28} }
 
 // A WhichErrs is the result of a 'whicherrs' query.
 // It contains the position of the queried error and the possible globals,
@@ -446,14 +513,15 @@ func (*Describe) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []i
 type WhichErrs struct {
 	ErrPos    string          `protobuf:"bytes,1,opt,name=ErrPos,proto3" json:"ErrPos,omitempty"`
 	Globals   []string        `protobuf:"bytes,2,rep,name=Globals" json:"Globals,omitempty"`
-	Constants string          `protobuf:"bytes,3,opt,name=Constants,proto3" json:"Constants,omitempty"`
+	Constants []string        `protobuf:"bytes,3,rep,name=Constants" json:"Constants,omitempty"`
 	Types     []WhichErrsType `protobuf:"bytes,4,rep,name=Types" json:"Types"`
+	Cached    bool            `protobuf:"varint,5,opt,name=Cached,proto3" json:"Cached,omitempty"`
 }
 
 func (m *WhichErrs) Reset()                    { *m = WhichErrs{} }
 func (m *WhichErrs) String() string            { return proto.CompactTextString(m) }
 func (*WhichErrs) ProtoMessage()               {}
-func (*WhichErrs) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{27} }
+func (*WhichErrs) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{29} }
 
 type WhichErrsType struct {
 	Type     string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
@@ -463,7 +531,163 @@ type WhichErrsType struct {
 func (m *WhichErrsType) Reset()                    { *m = WhichErrsType{} }
 func (m *WhichErrsType) String() string            { return proto.CompactTextString(m) }
 func (*WhichErrsType) ProtoMessage()               {}
-func (*WhichErrsType) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{28} }
+func (*WhichErrsType) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{30} }
+
+// Query is a query of any mode, whose output is printed by the daemon.
+type Query struct {
+	Mode     string    `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	Location *Location `protobuf:"bytes,2,opt,name=Location" json:"Location,omitempty"`
+	Format   string    `protobuf:"bytes,3,opt,name=Format,proto3" json:"Format,omitempty"`
+}
+
+func (m *Query) Reset()                    { *m = Query{} }
+func (m *Query) String() string            { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()               {}
+func (*Query) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{31} }
+
+// Output is a part of the output of a query, one result at a time.
+type Output struct {
+	Text   []byte `protobuf:"bytes,1,opt,name=Text,proto3" json:"Text,omitempty"`
+	Cached bool   `protobuf:"varint,2,opt,name=Cached,proto3" json:"Cached,omitempty"`
+}
+
+func (m *Output) Reset()                    { *m = Output{} }
+func (m *Output) String() string            { return proto.CompactTextString(m) }
+func (*Output) ProtoMessage()               {}
+func (*Output) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{32} }
+
+// BatchRequest is a batch of queries with the same options and
+// overlays, e.g. for every declaration of a file.
+type BatchRequest struct {
+	Items    []BatchItem `protobuf:"bytes,1,rep,name=Items" json:"Items"`
+	Options  *Options    `protobuf:"bytes,2,opt,name=Options" json:"Options,omitempty"`
+	Overlays []Overlay   `protobuf:"bytes,3,rep,name=Overlays" json:"Overlays"`
+	Format   string      `protobuf:"bytes,4,opt,name=Format,proto3" json:"Format,omitempty"`
+}
+
+func (m *BatchRequest) Reset()                    { *m = BatchRequest{} }
+func (m *BatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()               {}
+func (*BatchRequest) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{33} }
+
+// BatchItem is a query of a batch, at a position as in Location.
+type BatchItem struct {
+	Mode string `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	Pos  string `protobuf:"bytes,2,opt,name=Pos,proto3" json:"Pos,omitempty"`
+}
+
+func (m *BatchItem) Reset()                    { *m = BatchItem{} }
+func (m *BatchItem) String() string            { return proto.CompactTextString(m) }
+func (*BatchItem) ProtoMessage()               {}
+func (*BatchItem) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{34} }
+
+// BatchResult is the output of a query of a batch, or its error.
+type BatchResult struct {
+	Index   int64         `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Text    []byte        `protobuf:"bytes,2,opt,name=Text,proto3" json:"Text,omitempty"`
+	Code    int32         `protobuf:"varint,3,opt,name=Code,proto3" json:"Code,omitempty"`
+	Error   string        `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	Details *ErrorDetails `protobuf:"bytes,5,opt,name=Details" json:"Details,omitempty"`
+	Cached  bool          `protobuf:"varint,6,opt,name=Cached,proto3" json:"Cached,omitempty"`
+}
+
+func (m *BatchResult) Reset()                    { *m = BatchResult{} }
+func (m *BatchResult) String() string            { return proto.CompactTextString(m) }
+func (*BatchResult) ProtoMessage()               {}
+func (*BatchResult) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{35} }
+
+// DaemonStatus describes the state of the daemon.
+type DaemonStatus struct {
+	Version    string            `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	GoVersion  string            `protobuf:"bytes,2,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
+	Pid        int64             `protobuf:"varint,3,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	Uptime     int64             `protobuf:"varint,4,opt,name=Uptime,proto3" json:"Uptime,omitempty"`
+	HeapAlloc  uint64            `protobuf:"varint,5,opt,name=HeapAlloc,proto3" json:"HeapAlloc,omitempty"`
+	HeapSys    uint64            `protobuf:"varint,6,opt,name=HeapSys,proto3" json:"HeapSys,omitempty"`
+	Workspaces []WorkspaceStatus `protobuf:"bytes,7,rep,name=Workspaces" json:"Workspaces"`
+	Calls      []CallStatus      `protobuf:"bytes,8,rep,name=Calls" json:"Calls"`
+	Coalesced  int64             `protobuf:"varint,9,opt,name=Coalesced,proto3" json:"Coalesced,omitempty"`
+}
+
+func (m *DaemonStatus) Reset()                    { *m = DaemonStatus{} }
+func (m *DaemonStatus) String() string            { return proto.CompactTextString(m) }
+func (*DaemonStatus) ProtoMessage()               {}
+func (*DaemonStatus) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{36} }
+
+// WorkspaceStatus describes the caches of the workspace of a build context.
+type WorkspaceStatus struct {
+	GOROOT        string          `protobuf:"bytes,1,opt,name=GOROOT,proto3" json:"GOROOT,omitempty"`
+	GOPATH        string          `protobuf:"bytes,2,opt,name=GOPATH,proto3" json:"GOPATH,omitempty"`
+	GOOS          string          `protobuf:"bytes,3,opt,name=GOOS,proto3" json:"GOOS,omitempty"`
+	GOARCH        string          `protobuf:"bytes,4,opt,name=GOARCH,proto3" json:"GOARCH,omitempty"`
+	BuildTags     []string        `protobuf:"bytes,5,rep,name=BuildTags" json:"BuildTags,omitempty"`
+	CgoEnabled    bool            `protobuf:"varint,6,opt,name=CgoEnabled,proto3" json:"CgoEnabled,omitempty"`
+	Programs      []ProgramStatus `protobuf:"bytes,7,rep,name=Programs" json:"Programs"`
+	ProgramCache  Counters        `protobuf:"bytes,8,opt,name=ProgramCache" json:"ProgramCache"`
+	Reloads       int64           `protobuf:"varint,9,opt,name=Reloads,proto3" json:"Reloads,omitempty"`
+	Rebuilt       int64           `protobuf:"varint,10,opt,name=Rebuilt,proto3" json:"Rebuilt,omitempty"`
+	AnalysisCache Counters        `protobuf:"bytes,11,opt,name=AnalysisCache" json:"AnalysisCache"`
+	IndexedFiles  int64           `protobuf:"varint,12,opt,name=IndexedFiles,proto3" json:"IndexedFiles,omitempty"`
+	Indexing      bool            `protobuf:"varint,13,opt,name=Indexing,proto3" json:"Indexing,omitempty"`
+	Index         Counters        `protobuf:"bytes,14,opt,name=Index" json:"Index"`
+	ResultCache   Counters        `protobuf:"bytes,15,opt,name=ResultCache" json:"ResultCache"`
+}
+
+func (m *WorkspaceStatus) Reset()                    { *m = WorkspaceStatus{} }
+func (m *WorkspaceStatus) String() string            { return proto.CompactTextString(m) }
+func (*WorkspaceStatus) ProtoMessage()               {}
+func (*WorkspaceStatus) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{37} }
+
+// ProgramStatus describes a program held by the cache of a workspace.
+type ProgramStatus struct {
+	Scope           []string `protobuf:"bytes,1,rep,name=Scope" json:"Scope,omitempty"`
+	Packages        int64    `protobuf:"varint,2,opt,name=Packages,proto3" json:"Packages,omitempty"`
+	Loading         bool     `protobuf:"varint,3,opt,name=Loading,proto3" json:"Loading,omitempty"`
+	SSA             bool     `protobuf:"varint,4,opt,name=SSA,proto3" json:"SSA,omitempty"`
+	PointerAnalysis bool     `protobuf:"varint,5,opt,name=PointerAnalysis,proto3" json:"PointerAnalysis,omitempty"`
+}
+
+func (m *ProgramStatus) Reset()                    { *m = ProgramStatus{} }
+func (m *ProgramStatus) String() string            { return proto.CompactTextString(m) }
+func (*ProgramStatus) ProtoMessage()               {}
+func (*ProgramStatus) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{38} }
+
+// Counters counts the lookups in a cache.
+type Counters struct {
+	Hits   int64 `protobuf:"varint,1,opt,name=Hits,proto3" json:"Hits,omitempty"`
+	Misses int64 `protobuf:"varint,2,opt,name=Misses,proto3" json:"Misses,omitempty"`
+}
+
+func (m *Counters) Reset()                    { *m = Counters{} }
+func (m *Counters) String() string            { return proto.CompactTextString(m) }
+func (*Counters) ProtoMessage()               {}
+func (*Counters) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{39} }
+
+// CallStatus describes a request in progress.
+type CallStatus struct {
+	Method  string `protobuf:"bytes,1,opt,name=Method,proto3" json:"Method,omitempty"`
+	Mode    string `protobuf:"bytes,2,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	Pos     string `protobuf:"bytes,3,opt,name=Pos,proto3" json:"Pos,omitempty"`
+	Elapsed int64  `protobuf:"varint,4,opt,name=Elapsed,proto3" json:"Elapsed,omitempty"`
+}
+
+func (m *CallStatus) Reset()                    { *m = CallStatus{} }
+func (m *CallStatus) String() string            { return proto.CompactTextString(m) }
+func (*CallStatus) ProtoMessage()               {}
+func (*CallStatus) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{40} }
+
+// ErrorDetails describes why a query failed.  The daemon attaches it to
+// the InvalidArgument, NotFound and FailedPrecondition errors of queries.
+type ErrorDetails struct {
+	Packages []string `protobuf:"bytes,1,rep,name=Packages" json:"Packages,omitempty"`
+	Pos      string   `protobuf:"bytes,2,opt,name=Pos,proto3" json:"Pos,omitempty"`
+	Modes    []string `protobuf:"bytes,3,rep,name=Modes" json:"Modes,omitempty"`
+}
+
+func (m *ErrorDetails) Reset()                    { *m = ErrorDetails{} }
+func (m *ErrorDetails) String() string            { return proto.CompactTextString(m) }
+func (*ErrorDetails) ProtoMessage()               {}
+func (*ErrorDetails) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{41} }
 
 type Request struct {
 }
@@ -471,7 +695,7 @@ type Request struct {
 func (m *Request) Reset()                    { *m = Request{} }
 func (m *Request) String() string            { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()               {}
-func (*Request) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{29} }
+func (*Request) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{42} }
 
 type Response struct {
 }
@@ -479,15 +703,17 @@ type Response struct {
 func (m *Response) Reset()                    { *m = Response{} }
 func (m *Response) String() string            { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()               {}
-func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{30} }
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorSerial, []int{43} }
 
 func init() {
 	proto.RegisterType((*Location)(nil), "serial.Location")
+	proto.RegisterType((*Overlay)(nil), "serial.Overlay")
 	proto.RegisterType((*Options)(nil), "serial.Options")
 	proto.RegisterType((*Peers)(nil), "serial.Peers")
 	proto.RegisterType((*ReferrersInitial)(nil), "serial.ReferrersInitial")
 	proto.RegisterType((*ReferrersPackage)(nil), "serial.ReferrersPackage")
 	proto.RegisterType((*Ref)(nil), "serial.Ref")
+	proto.RegisterType((*ReferrersEvent)(nil), "serial.ReferrersEvent")
 	proto.RegisterType((*Definition)(nil), "serial.Definition")
 	proto.RegisterType((*Callees)(nil), "serial.Callees")
 	proto.RegisterType((*Callee)(nil), "serial.Callee")
@@ -511,6 +737,17 @@ func init() {
 	proto.RegisterType((*Describe)(nil), "serial.Describe")
 	proto.RegisterType((*WhichErrs)(nil), "serial.WhichErrs")
 	proto.RegisterType((*WhichErrsType)(nil), "serial.WhichErrsType")
+	proto.RegisterType((*Query)(nil), "serial.Query")
+	proto.RegisterType((*Output)(nil), "serial.Output")
+	proto.RegisterType((*BatchRequest)(nil), "serial.BatchRequest")
+	proto.RegisterType((*BatchItem)(nil), "serial.BatchItem")
+	proto.RegisterType((*BatchResult)(nil), "serial.BatchResult")
+	proto.RegisterType((*DaemonStatus)(nil), "serial.DaemonStatus")
+	proto.RegisterType((*WorkspaceStatus)(nil), "serial.WorkspaceStatus")
+	proto.RegisterType((*ProgramStatus)(nil), "serial.ProgramStatus")
+	proto.RegisterType((*Counters)(nil), "serial.Counters")
+	proto.RegisterType((*CallStatus)(nil), "serial.CallStatus")
+	proto.RegisterType((*ErrorDetails)(nil), "serial.ErrorDetails")
 	proto.RegisterType((*Request)(nil), "serial.Request")
 	proto.RegisterType((*Response)(nil), "serial.Response")
 }
@@ -527,6 +764,10 @@ const _ = grpc.SupportPackageIsVersion4
 
 type GodClient interface {
 	Ping(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	// Shutdown stops the daemon once the queries in progress are done.
+	Shutdown(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	// Status describes what the daemon holds and is doing.
+	Status(ctx context.Context, in *Request, opts ...grpc.CallOption) (*DaemonStatus, error)
 	GetCallees(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Callees, error)
 	GetCallers(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Callers, error)
 	GetCallStack(ctx context.Context, in *Location, opts ...grpc.CallOption) (*CallStack, error)
@@ -537,8 +778,14 @@ type GodClient interface {
 	GetPeers(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Peers, error)
 	GetPointsTo(ctx context.Context, in *Location, opts ...grpc.CallOption) (*PointsTos, error)
 	GetReferrers(ctx context.Context, in *Location, opts ...grpc.CallOption) (*ReferrersPackage, error)
+	StreamReferrers(ctx context.Context, in *Location, opts ...grpc.CallOption) (God_StreamReferrersClient, error)
 	GetWhat(ctx context.Context, in *Location, opts ...grpc.CallOption) (*What, error)
 	GetWhichErrs(ctx context.Context, in *Location, opts ...grpc.CallOption) (*WhichErrs, error)
+	// Print streams the output of a query, as guru prints it.
+	Print(ctx context.Context, in *Query, opts ...grpc.CallOption) (God_PrintClient, error)
+	// Batch runs several queries at once and streams the result of each
+	// one as soon as it is done; a failed query does not fail the batch.
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (God_BatchClient, error)
 }
 
 type godClient struct {
@@ -558,6 +805,24 @@ func (c *godClient) Ping(ctx context.Context, in *Request, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *godClient) Shutdown(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/serial.God/Shutdown", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *godClient) Status(ctx context.Context, in *Request, opts ...grpc.CallOption) (*DaemonStatus, error) {
+	out := new(DaemonStatus)
+	err := grpc.Invoke(ctx, "/serial.God/Status", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *godClient) GetCallees(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Callees, error) {
 	out := new(Callees)
 	err := grpc.Invoke(ctx, "/serial.God/GetCallees", in, out, c.cc, opts...)
@@ -648,6 +913,38 @@ func (c *godClient) GetReferrers(ctx context.Context, in *Location, opts ...grpc
 	return out, nil
 }
 
+func (c *godClient) StreamReferrers(ctx context.Context, in *Location, opts ...grpc.CallOption) (God_StreamReferrersClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_God_serviceDesc.Streams[0], c.cc, "/serial.God/StreamReferrers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &godStreamReferrersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type God_StreamReferrersClient interface {
+	Recv() (*ReferrersEvent, error)
+	grpc.ClientStream
+}
+
+type godStreamReferrersClient struct {
+	grpc.ClientStream
+}
+
+func (x *godStreamReferrersClient) Recv() (*ReferrersEvent, error) {
+	m := new(ReferrersEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *godClient) GetWhat(ctx context.Context, in *Location, opts ...grpc.CallOption) (*What, error) {
 	out := new(What)
 	err := grpc.Invoke(ctx, "/serial.God/GetWhat", in, out, c.cc, opts...)
@@ -666,10 +963,78 @@ func (c *godClient) GetWhichErrs(ctx context.Context, in *Location, opts ...grpc
 	return out, nil
 }
 
+func (c *godClient) Print(ctx context.Context, in *Query, opts ...grpc.CallOption) (God_PrintClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_God_serviceDesc.Streams[1], c.cc, "/serial.God/Print", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &godPrintClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type God_PrintClient interface {
+	Recv() (*Output, error)
+	grpc.ClientStream
+}
+
+type godPrintClient struct {
+	grpc.ClientStream
+}
+
+func (x *godPrintClient) Recv() (*Output, error) {
+	m := new(Output)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *godClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (God_BatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_God_serviceDesc.Streams[2], c.cc, "/serial.God/Batch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &godBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type God_BatchClient interface {
+	Recv() (*BatchResult, error)
+	grpc.ClientStream
+}
+
+type godBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *godBatchClient) Recv() (*BatchResult, error) {
+	m := new(BatchResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for God service
 
 type GodServer interface {
 	Ping(context.Context, *Request) (*Response, error)
+	// Shutdown stops the daemon once the queries in progress are done.
+	Shutdown(context.Context, *Request) (*Response, error)
+	// Status describes what the daemon holds and is doing.
+	Status(context.Context, *Request) (*DaemonStatus, error)
 	GetCallees(context.Context, *Location) (*Callees, error)
 	GetCallers(context.Context, *Location) (*Callers, error)
 	GetCallStack(context.Context, *Location) (*CallStack, error)
@@ -680,8 +1045,14 @@ type GodServer interface {
 	GetPeers(context.Context, *Location) (*Peers, error)
 	GetPointsTo(context.Context, *Location) (*PointsTos, error)
 	GetReferrers(context.Context, *Location) (*ReferrersPackage, error)
+	StreamReferrers(*Location, God_StreamReferrersServer) error
 	GetWhat(context.Context, *Location) (*What, error)
 	GetWhichErrs(context.Context, *Location) (*WhichErrs, error)
+	// Print streams the output of a query, as guru prints it.
+	Print(*Query, God_PrintServer) error
+	// Batch runs several queries at once and streams the result of each
+	// one as soon as it is done; a failed query does not fail the batch.
+	Batch(*BatchRequest, God_BatchServer) error
 }
 
 func RegisterGodServer(s *grpc.Server, srv GodServer) {
@@ -706,6 +1077,42 @@ func _God_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _God_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GodServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/serial.God/Shutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GodServer).Shutdown(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _God_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GodServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/serial.God/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GodServer).Status(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _God_GetCallees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Location)
 	if err := dec(in); err != nil {
@@ -886,6 +1293,27 @@ func _God_GetReferrers_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _God_StreamReferrers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Location)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GodServer).StreamReferrers(m, &godStreamReferrersServer{stream})
+}
+
+type God_StreamReferrersServer interface {
+	Send(*ReferrersEvent) error
+	grpc.ServerStream
+}
+
+type godStreamReferrersServer struct {
+	grpc.ServerStream
+}
+
+func (x *godStreamReferrersServer) Send(m *ReferrersEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _God_GetWhat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Location)
 	if err := dec(in); err != nil {
@@ -922,6 +1350,48 @@ func _God_GetWhichErrs_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _God_Print_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Query)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GodServer).Print(m, &godPrintServer{stream})
+}
+
+type God_PrintServer interface {
+	Send(*Output) error
+	grpc.ServerStream
+}
+
+type godPrintServer struct {
+	grpc.ServerStream
+}
+
+func (x *godPrintServer) Send(m *Output) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _God_Batch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GodServer).Batch(m, &godBatchServer{stream})
+}
+
+type God_BatchServer interface {
+	Send(*BatchResult) error
+	grpc.ServerStream
+}
+
+type godBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *godBatchServer) Send(m *BatchResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _God_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "serial.God",
 	HandlerType: (*GodServer)(nil),
@@ -930,6 +1400,14 @@ var _God_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _God_Ping_Handler,
 		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _God_Shutdown_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _God_Status_Handler,
+		},
 		{
 			MethodName: "GetCallees",
 			Handler:    _God_GetCallees_Handler,
@@ -979,7 +1457,23 @@ var _God_serviceDesc = grpc.ServiceDesc{
 			Handler:    _God_GetWhichErrs_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamReferrers",
+			Handler:       _God_StreamReferrers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Print",
+			Handler:       _God_Print_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Batch",
+			Handler:       _God_Batch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "serial/serial.proto",
 }
 
@@ -1030,6 +1524,48 @@ func (m *Location) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.Overlays) > 0 {
+		for _, msg := range m.Overlays {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintSerial(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Overlay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Overlay) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filename) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Filename)))
+		i += copy(dAtA[i:], m.Filename)
+	}
+	if len(m.Content) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Content)))
+		i += copy(dAtA[i:], m.Content)
+	}
 	return i, nil
 }
 
@@ -1054,6 +1590,75 @@ func (m *Options) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintSerial(dAtA, i, uint64(len(m.Scope)))
 		i += copy(dAtA[i:], m.Scope)
 	}
+	if len(m.GOROOT) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.GOROOT)))
+		i += copy(dAtA[i:], m.GOROOT)
+	}
+	if len(m.GOPATH) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.GOPATH)))
+		i += copy(dAtA[i:], m.GOPATH)
+	}
+	if len(m.GOOS) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.GOOS)))
+		i += copy(dAtA[i:], m.GOOS)
+	}
+	if len(m.GOARCH) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.GOARCH)))
+		i += copy(dAtA[i:], m.GOARCH)
+	}
+	if len(m.BuildTags) > 0 {
+		for _, s := range m.BuildTags {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.CgoEnabled {
+		dAtA[i] = 0x38
+		i++
+		if m.CgoEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Reflection {
+		dAtA[i] = 0x40
+		i++
+		if m.Reflection {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.CgoEnabledSet {
+		dAtA[i] = 0x48
+		i++
+		if m.CgoEnabledSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1144,6 +1749,16 @@ func (m *Peers) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x38
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1210,6 +1825,16 @@ func (m *ReferrersPackage) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x18
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1243,7 +1868,7 @@ func (m *Ref) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Definition) Marshal() (dAtA []byte, err error) {
+func (m *ReferrersEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1253,27 +1878,35 @@ func (m *Definition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Definition) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReferrersEvent) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ObjPos) > 0 {
+	if m.Initial != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSerial(dAtA, i, uint64(len(m.ObjPos)))
-		i += copy(dAtA[i:], m.ObjPos)
+		i = encodeVarintSerial(dAtA, i, uint64(m.Initial.Size()))
+		n2, err := m.Initial.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
 	}
-	if len(m.Desc) > 0 {
+	if m.Package != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintSerial(dAtA, i, uint64(len(m.Desc)))
-		i += copy(dAtA[i:], m.Desc)
+		i = encodeVarintSerial(dAtA, i, uint64(m.Package.Size()))
+		n3, err := m.Package.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
 	}
 	return i, nil
 }
 
-func (m *Callees) Marshal() (dAtA []byte, err error) {
+func (m *Definition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1283,17 +1916,57 @@ func (m *Callees) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Callees) MarshalTo(dAtA []byte) (int, error) {
+func (m *Definition) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pos) > 0 {
+	if len(m.ObjPos) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintSerial(dAtA, i, uint64(len(m.Pos)))
-		i += copy(dAtA[i:], m.Pos)
-	}
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.ObjPos)))
+		i += copy(dAtA[i:], m.ObjPos)
+	}
+	if len(m.Desc) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Desc)))
+		i += copy(dAtA[i:], m.Desc)
+	}
+	if m.Cached {
+		dAtA[i] = 0x18
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Callees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Callees) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pos) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Pos)))
+		i += copy(dAtA[i:], m.Pos)
+	}
 	if len(m.Desc) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -1312,6 +1985,16 @@ func (m *Callees) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x20
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1372,6 +2055,16 @@ func (m *Callers) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x10
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1450,6 +2143,16 @@ func (m *CallStack) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x20
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1480,6 +2183,16 @@ func (m *FreeVars) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x10
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1543,11 +2256,11 @@ func (m *Implements) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintSerial(dAtA, i, uint64(m.T.Size()))
-	n2, err := m.T.MarshalTo(dAtA[i:])
+	n4, err := m.T.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n2
+	i += n4
 	if len(m.AssignableTo) > 0 {
 		for _, msg := range m.AssignableTo {
 			dAtA[i] = 0x12
@@ -1588,11 +2301,11 @@ func (m *Implements) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintSerial(dAtA, i, uint64(m.Method.Size()))
-		n3, err := m.Method.MarshalTo(dAtA[i:])
+		n5, err := m.Method.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n5
 	}
 	if len(m.AssignableToMethod) > 0 {
 		for _, msg := range m.AssignableToMethod {
@@ -1630,6 +2343,16 @@ func (m *Implements) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x48
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1778,6 +2501,16 @@ func (m *What) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x38
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1838,6 +2571,16 @@ func (m *PointsTos) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x10
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -2130,31 +2873,41 @@ func (m *Describe) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintSerial(dAtA, i, uint64(m.Package.Size()))
-		n4, err := m.Package.MarshalTo(dAtA[i:])
+		n6, err := m.Package.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	if m.Type != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintSerial(dAtA, i, uint64(m.Type.Size()))
-		n5, err := m.Type.MarshalTo(dAtA[i:])
+		n7, err := m.Type.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if m.Value != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintSerial(dAtA, i, uint64(m.Value.Size()))
-		n6, err := m.Value.MarshalTo(dAtA[i:])
+		n8, err := m.Value.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n8
+	}
+	if m.Cached {
+		dAtA[i] = 0x38
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	return i, nil
 }
@@ -2196,10 +2949,19 @@ func (m *WhichErrs) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Constants) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintSerial(dAtA, i, uint64(len(m.Constants)))
-		i += copy(dAtA[i:], m.Constants)
+		for _, s := range m.Constants {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if len(m.Types) > 0 {
 		for _, msg := range m.Types {
@@ -2213,6 +2975,16 @@ func (m *WhichErrs) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Cached {
+		dAtA[i] = 0x28
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -2246,7 +3018,7 @@ func (m *WhichErrsType) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
+func (m *Query) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2256,15 +3028,37 @@ func (m *Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *Query) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
+	}
+	if m.Location != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Location.Size()))
+		n9, err := m.Location.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
 	return i, nil
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
+func (m *Output) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2274,513 +3068,736 @@ func (m *Response) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+func (m *Output) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Text) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
+	}
+	if m.Cached {
+		dAtA[i] = 0x10
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
-func encodeFixed64Serial(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Serial(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintSerial(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Location) Size() (n int) {
+
+func (m *BatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Filename)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSerial(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Line != 0 {
-		n += 1 + sovSerial(uint64(m.Line))
+	if m.Options != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Options.Size()))
+		n10, err := m.Options.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
-	if m.Col != 0 {
-		n += 1 + sovSerial(uint64(m.Col))
+	if len(m.Overlays) > 0 {
+		for _, msg := range m.Overlays {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintSerial(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
 	}
-	if m.Options != nil {
-		l = m.Options.Size()
-		n += 1 + l + sovSerial(uint64(l))
+	return i, nil
+}
+
+func (m *BatchItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Options) Size() (n int) {
+func (m *BatchItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Scope)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
 	}
-	return n
+	if len(m.Pos) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Pos)))
+		i += copy(dAtA[i:], m.Pos)
+	}
+	return i, nil
 }
 
-func (m *Peers) Size() (n int) {
+func (m *BatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Index))
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Text) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
 	}
-	if len(m.Allocs) > 0 {
-		for _, s := range m.Allocs {
-			l = len(s)
-			n += 1 + l + sovSerial(uint64(l))
-		}
+	if m.Code != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Code))
 	}
-	if len(m.Sends) > 0 {
-		for _, s := range m.Sends {
-			l = len(s)
-			n += 1 + l + sovSerial(uint64(l))
-		}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
 	}
-	if len(m.Receives) > 0 {
-		for _, s := range m.Receives {
-			l = len(s)
-			n += 1 + l + sovSerial(uint64(l))
+	if m.Details != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Details.Size()))
+		n11, err := m.Details.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n11
 	}
-	if len(m.Closes) > 0 {
-		for _, s := range m.Closes {
-			l = len(s)
-			n += 1 + l + sovSerial(uint64(l))
+	if m.Cached {
+		dAtA[i] = 0x30
+		i++
+		if m.Cached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReferrersInitial) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ObjPos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.Desc)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+func (m *DaemonStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReferrersPackage) Size() (n int) {
+func (m *DaemonStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Package)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
 	}
-	if len(m.Refs) > 0 {
-		for _, e := range m.Refs {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
+	if len(m.GoVersion) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.GoVersion)))
+		i += copy(dAtA[i:], m.GoVersion)
+	}
+	if m.Pid != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Pid))
+	}
+	if m.Uptime != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Uptime))
+	}
+	if m.HeapAlloc != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.HeapAlloc))
+	}
+	if m.HeapSys != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.HeapSys))
+	}
+	if len(m.Workspaces) > 0 {
+		for _, msg := range m.Workspaces {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintSerial(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
-}
-
-func (m *Ref) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Calls) > 0 {
+		for _, msg := range m.Calls {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintSerial(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Text)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if m.Coalesced != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Coalesced))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Definition) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.ObjPos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.Desc)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+func (m *WorkspaceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Callees) Size() (n int) {
+func (m *WorkspaceStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.GOROOT) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.GOROOT)))
+		i += copy(dAtA[i:], m.GOROOT)
 	}
-	l = len(m.Desc)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.GOPATH) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.GOPATH)))
+		i += copy(dAtA[i:], m.GOPATH)
 	}
-	if len(m.Callees) > 0 {
-		for _, e := range m.Callees {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
-		}
+	if len(m.GOOS) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.GOOS)))
+		i += copy(dAtA[i:], m.GOOS)
 	}
-	return n
-}
-
-func (m *Callee) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.GOARCH) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.GOARCH)))
+		i += copy(dAtA[i:], m.GOARCH)
 	}
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.BuildTags) > 0 {
+		for _, s := range m.BuildTags {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	return n
-}
-
-func (m *Callers) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Callers) > 0 {
-		for _, e := range m.Callers {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
+	if m.CgoEnabled {
+		dAtA[i] = 0x30
+		i++
+		if m.CgoEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
-}
-
-func (m *Caller) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Programs) > 0 {
+		for _, msg := range m.Programs {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintSerial(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Desc)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintSerial(dAtA, i, uint64(m.ProgramCache.Size()))
+	n12, err := m.ProgramCache.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	i += n12
+	if m.Reloads != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Reloads))
 	}
-	return n
-}
-
-func (m *CallStack) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if m.Rebuilt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Rebuilt))
 	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintSerial(dAtA, i, uint64(m.AnalysisCache.Size()))
+	n13, err := m.AnalysisCache.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if len(m.Callers) > 0 {
-		for _, e := range m.Callers {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
+	i += n13
+	if m.IndexedFiles != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.IndexedFiles))
+	}
+	if m.Indexing {
+		dAtA[i] = 0x68
+		i++
+		if m.Indexing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
+	dAtA[i] = 0x72
+	i++
+	i = encodeVarintSerial(dAtA, i, uint64(m.Index.Size()))
+	n14, err := m.Index.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n14
+	dAtA[i] = 0x7a
+	i++
+	i = encodeVarintSerial(dAtA, i, uint64(m.ResultCache.Size()))
+	n15, err := m.ResultCache.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n15
+	return i, nil
 }
 
-func (m *FreeVars) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.FreeVar) > 0 {
-		for _, e := range m.FreeVar {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
-		}
+func (m *ProgramStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FreeVar) Size() (n int) {
+func (m *ProgramStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.Ref)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	return n
-}
-
-func (m *Implements) Size() (n int) {
-	var l int
-	_ = l
-	l = m.T.Size()
-	n += 1 + l + sovSerial(uint64(l))
-	if len(m.AssignableTo) > 0 {
-		for _, e := range m.AssignableTo {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
+	if len(m.Scope) > 0 {
+		for _, s := range m.Scope {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.AssignableFrom) > 0 {
-		for _, e := range m.AssignableFrom {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
-		}
+	if m.Packages != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Packages))
 	}
-	if len(m.AssignableFromPtr) > 0 {
-		for _, e := range m.AssignableFromPtr {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
+	if m.Loading {
+		dAtA[i] = 0x18
+		i++
+		if m.Loading {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.Method != nil {
-		l = m.Method.Size()
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	if len(m.AssignableToMethod) > 0 {
-		for _, e := range m.AssignableToMethod {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
+	if m.SSA {
+		dAtA[i] = 0x20
+		i++
+		if m.SSA {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.AssignableFromMethod) > 0 {
-		for _, e := range m.AssignableFromMethod {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
+	if m.PointerAnalysis {
+		dAtA[i] = 0x28
+		i++
+		if m.PointerAnalysis {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.AssignableFromPtrMethod) > 0 {
-		for _, e := range m.AssignableFromPtrMethod {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
-		}
+	return i, nil
+}
+
+func (m *Counters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ImplementsType) Size() (n int) {
+func (m *Counters) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if m.Hits != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Hits))
 	}
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if m.Misses != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Misses))
 	}
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	return i, nil
+}
+
+func (m *CallStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SyntaxNode) Size() (n int) {
+func (m *CallStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Method) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
 	}
-	if m.Start != 0 {
-		n += 1 + sovSerial(uint64(m.Start))
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
 	}
-	if m.End != 0 {
-		n += 1 + sovSerial(uint64(m.End))
+	if len(m.Pos) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Pos)))
+		i += copy(dAtA[i:], m.Pos)
 	}
-	return n
+	if m.Elapsed != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(m.Elapsed))
+	}
+	return i, nil
 }
 
-func (m *What) Size() (n int) {
+func (m *ErrorDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorDetails) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Enclosing) > 0 {
-		for _, e := range m.Enclosing {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
-		}
-	}
-	if len(m.Modes) > 0 {
-		for _, s := range m.Modes {
+	if len(m.Packages) > 0 {
+		for _, s := range m.Packages {
+			dAtA[i] = 0xa
+			i++
 			l = len(s)
-			n += 1 + l + sovSerial(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = len(m.SrcDir)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.ImportPath)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.Object)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Pos) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSerial(dAtA, i, uint64(len(m.Pos)))
+		i += copy(dAtA[i:], m.Pos)
 	}
-	if len(m.SameIDs) > 0 {
-		for _, s := range m.SameIDs {
+	if len(m.Modes) > 0 {
+		for _, s := range m.Modes {
+			dAtA[i] = 0x1a
+			i++
 			l = len(s)
-			n += 1 + l + sovSerial(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *PointsToLabel) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Pos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.Desc)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PointsTos) Size() (n int) {
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.PointsTos) > 0 {
-		for _, e := range m.PointsTos {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
-		}
-	}
-	return n
+	return i, nil
 }
 
-func (m *PointsTo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.NamePos)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for _, e := range m.Labels {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
-		}
+func (m *Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeValue) Size() (n int) {
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	return i, nil
+}
+
+func encodeFixed64Serial(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Serial(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintSerial(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	l = len(m.ObjPos)
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Location) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Line != 0 {
+		n += 1 + sovSerial(uint64(m.Line))
+	}
+	if m.Col != 0 {
+		n += 1 + sovSerial(uint64(m.Col))
+	}
+	l = len(m.Pos)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Overlays) > 0 {
+		for _, e := range m.Overlays {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *DescribeMethod) Size() (n int) {
+func (m *Overlay) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Filename)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	l = len(m.Pos)
+	l = len(m.Content)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
 	return n
 }
 
-func (m *DescribeType) Size() (n int) {
+func (m *Options) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Type)
+	l = len(m.Scope)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	l = len(m.NamePos)
+	l = len(m.GOROOT)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	l = len(m.NameDef)
+	l = len(m.GOPATH)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	if len(m.Methods) > 0 {
-		for _, e := range m.Methods {
-			l = e.Size()
+	l = len(m.GOOS)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.GOARCH)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.BuildTags) > 0 {
+		for _, s := range m.BuildTags {
+			l = len(s)
 			n += 1 + l + sovSerial(uint64(l))
 		}
 	}
+	if m.CgoEnabled {
+		n += 2
+	}
+	if m.Reflection {
+		n += 2
+	}
+	if m.CgoEnabledSet {
+		n += 2
+	}
 	return n
 }
 
-func (m *DescribeMember) Size() (n int) {
+func (m *Peers) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Pos)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
@@ -2788,139 +3805,3002 @@ func (m *DescribeMember) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovSerial(uint64(l))
+	if len(m.Allocs) > 0 {
+		for _, s := range m.Allocs {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
 	}
-	l = len(m.Pos)
+	if len(m.Sends) > 0 {
+		for _, s := range m.Sends {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.Receives) > 0 {
+		for _, s := range m.Receives {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.Closes) > 0 {
+		for _, s := range m.Closes {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReferrersInitial) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ObjPos)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	l = len(m.Kind)
+	l = len(m.Desc)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	if len(m.Methods) > 0 {
-		for _, e := range m.Methods {
-			l = e.Size()
-			n += 1 + l + sovSerial(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *DescribePackage) Size() (n int) {
+func (m *ReferrersPackage) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Path)
+	l = len(m.Package)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
+	if len(m.Refs) > 0 {
+		for _, e := range m.Refs {
 			l = e.Size()
 			n += 1 + l + sovSerial(uint64(l))
 		}
 	}
+	if m.Cached {
+		n += 2
+	}
 	return n
 }
 
-func (m *Describe) Size() (n int) {
+func (m *Ref) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Desc)
+	l = len(m.Pos)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	l = len(m.Pos)
+	l = len(m.Text)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	l = len(m.Detail)
-	if l > 0 {
+	return n
+}
+
+func (m *ReferrersEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Initial != nil {
+		l = m.Initial.Size()
 		n += 1 + l + sovSerial(uint64(l))
 	}
 	if m.Package != nil {
 		l = m.Package.Size()
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	if m.Type != nil {
-		l = m.Type.Size()
+	return n
+}
+
+func (m *Definition) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ObjPos)
+	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
+	l = len(m.Desc)
+	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
+	if m.Cached {
+		n += 2
+	}
 	return n
 }
 
-func (m *WhichErrs) Size() (n int) {
+func (m *Callees) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ErrPos)
+	l = len(m.Pos)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	if len(m.Globals) > 0 {
-		for _, s := range m.Globals {
-			l = len(s)
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Callees) > 0 {
+		for _, e := range m.Callees {
+			l = e.Size()
 			n += 1 + l + sovSerial(uint64(l))
 		}
 	}
-	l = len(m.Constants)
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *Callee) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Pos)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	if len(m.Types) > 0 {
-		for _, e := range m.Types {
+	return n
+}
+
+func (m *Callers) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Callers) > 0 {
+		for _, e := range m.Callers {
 			l = e.Size()
 			n += 1 + l + sovSerial(uint64(l))
 		}
 	}
+	if m.Cached {
+		n += 2
+	}
 	return n
 }
 
-func (m *WhichErrsType) Size() (n int) {
+func (m *Caller) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Type)
+	l = len(m.Pos)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
-	l = len(m.Position)
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Caller)
 	if l > 0 {
 		n += 1 + l + sovSerial(uint64(l))
 	}
 	return n
 }
 
-func (m *Request) Size() (n int) {
+func (m *CallStack) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Callers) > 0 {
+		for _, e := range m.Callers {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Cached {
+		n += 2
+	}
 	return n
 }
 
-func (m *Response) Size() (n int) {
+func (m *FreeVars) Size() (n int) {
 	var l int
 	_ = l
+	if len(m.FreeVar) > 0 {
+		for _, e := range m.FreeVar {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Cached {
+		n += 2
+	}
 	return n
 }
 
-func sovSerial(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *FreeVar) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	return n
+}
+
+func (m *Implements) Size() (n int) {
+	var l int
+	_ = l
+	l = m.T.Size()
+	n += 1 + l + sovSerial(uint64(l))
+	if len(m.AssignableTo) > 0 {
+		for _, e := range m.AssignableTo {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.AssignableFrom) > 0 {
+		for _, e := range m.AssignableFrom {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.AssignableFromPtr) > 0 {
+		for _, e := range m.AssignableFromPtr {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Method != nil {
+		l = m.Method.Size()
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.AssignableToMethod) > 0 {
+		for _, e := range m.AssignableToMethod {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.AssignableFromMethod) > 0 {
+		for _, e := range m.AssignableFromMethod {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.AssignableFromPtrMethod) > 0 {
+		for _, e := range m.AssignableFromPtrMethod {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *ImplementsType) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	return n
+}
+
+func (m *SyntaxNode) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovSerial(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovSerial(uint64(m.End))
+	}
+	return n
+}
+
+func (m *What) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Enclosing) > 0 {
+		for _, e := range m.Enclosing {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.Modes) > 0 {
+		for _, s := range m.Modes {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	l = len(m.SrcDir)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.ImportPath)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.SameIDs) > 0 {
+		for _, s := range m.SameIDs {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *PointsToLabel) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	return n
+}
+
+func (m *PointsTos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.PointsTos) > 0 {
+		for _, e := range m.PointsTos {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *PointsTo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.NamePos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DescribeValue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.ObjPos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeMethod) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeType) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.NamePos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.NameDef)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Methods) > 0 {
+		for _, e := range m.Methods {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DescribeMember) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Methods) > 0 {
+		for _, e := range m.Methods {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DescribePackage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Describe) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Package != nil {
+		l = m.Package.Size()
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Type != nil {
+		l = m.Type.Size()
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *WhichErrs) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ErrPos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Globals) > 0 {
+		for _, s := range m.Globals {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.Constants) > 0 {
+		for _, s := range m.Constants {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *WhichErrsType) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Position)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	return n
+}
+
+func (m *Query) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Location != nil {
+		l = m.Location.Size()
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	return n
+}
+
+func (m *Output) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Overlays) > 0 {
+		for _, e := range m.Overlays {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchItem) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovSerial(uint64(m.Index))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovSerial(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Cached {
+		n += 2
+	}
+	return n
+}
+
+func (m *DaemonStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.GoVersion)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Pid != 0 {
+		n += 1 + sovSerial(uint64(m.Pid))
+	}
+	if m.Uptime != 0 {
+		n += 1 + sovSerial(uint64(m.Uptime))
+	}
+	if m.HeapAlloc != 0 {
+		n += 1 + sovSerial(uint64(m.HeapAlloc))
+	}
+	if m.HeapSys != 0 {
+		n += 1 + sovSerial(uint64(m.HeapSys))
+	}
+	if len(m.Workspaces) > 0 {
+		for _, e := range m.Workspaces {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Coalesced != 0 {
+		n += 1 + sovSerial(uint64(m.Coalesced))
+	}
+	return n
+}
+
+func (m *WorkspaceStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.GOROOT)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.GOPATH)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.GOOS)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.GOARCH)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.BuildTags) > 0 {
+		for _, s := range m.BuildTags {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.CgoEnabled {
+		n += 2
+	}
+	if len(m.Programs) > 0 {
+		for _, e := range m.Programs {
+			l = e.Size()
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	l = m.ProgramCache.Size()
+	n += 1 + l + sovSerial(uint64(l))
+	if m.Reloads != 0 {
+		n += 1 + sovSerial(uint64(m.Reloads))
+	}
+	if m.Rebuilt != 0 {
+		n += 1 + sovSerial(uint64(m.Rebuilt))
+	}
+	l = m.AnalysisCache.Size()
+	n += 1 + l + sovSerial(uint64(l))
+	if m.IndexedFiles != 0 {
+		n += 1 + sovSerial(uint64(m.IndexedFiles))
+	}
+	if m.Indexing {
+		n += 2
+	}
+	l = m.Index.Size()
+	n += 1 + l + sovSerial(uint64(l))
+	l = m.ResultCache.Size()
+	n += 1 + l + sovSerial(uint64(l))
+	return n
+}
+
+func (m *ProgramStatus) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Scope) > 0 {
+		for _, s := range m.Scope {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	if m.Packages != 0 {
+		n += 1 + sovSerial(uint64(m.Packages))
+	}
+	if m.Loading {
+		n += 2
+	}
+	if m.SSA {
+		n += 2
+	}
+	if m.PointerAnalysis {
+		n += 2
+	}
+	return n
+}
+
+func (m *Counters) Size() (n int) {
+	var l int
+	_ = l
+	if m.Hits != 0 {
+		n += 1 + sovSerial(uint64(m.Hits))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovSerial(uint64(m.Misses))
+	}
+	return n
+}
+
+func (m *CallStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if m.Elapsed != 0 {
+		n += 1 + sovSerial(uint64(m.Elapsed))
+	}
+	return n
+}
+
+func (m *ErrorDetails) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Packages) > 0 {
+		for _, s := range m.Packages {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	l = len(m.Pos)
+	if l > 0 {
+		n += 1 + l + sovSerial(uint64(l))
+	}
+	if len(m.Modes) > 0 {
+		for _, s := range m.Modes {
+			l = len(s)
+			n += 1 + l + sovSerial(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func sovSerial(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozSerial(x uint64) (n int) {
+	return sovSerial(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Location) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Location: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Location: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
+			}
+			m.Line = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Line |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			m.Col = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Col |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &Options{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overlays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overlays = append(m.Overlays, Overlay{})
+			if err := m.Overlays[len(m.Overlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Overlay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Overlay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Overlay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = append(m.Content[:0], dAtA[iNdEx:postIndex]...)
+			if m.Content == nil {
+				m.Content = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Options) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Options: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Options: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GOROOT", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GOROOT = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GOPATH", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GOPATH = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GOOS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GOOS = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GOARCH", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GOARCH = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildTags = append(m.BuildTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CgoEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CgoEnabled = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reflection", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reflection = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CgoEnabledSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CgoEnabledSet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Peers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Peers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Peers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocs = append(m.Allocs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sends", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sends = append(m.Sends, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receives = append(m.Receives, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Closes = append(m.Closes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrersInitial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrersInitial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrersInitial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjPos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjPos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Desc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrersPackage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrersPackage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrersPackage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refs = append(m.Refs, Ref{})
+			if err := m.Refs[len(m.Refs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ref) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ref: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ref: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrersEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrersEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrersEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initial", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Initial == nil {
+				m.Initial = &ReferrersInitial{}
+			}
+			if err := m.Initial.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Package == nil {
+				m.Package = &ReferrersPackage{}
+			}
+			if err := m.Package.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Definition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Definition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Definition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjPos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjPos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Desc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Callees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Callees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Callees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Desc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callees = append(m.Callees, &Callee{})
+			if err := m.Callees[len(m.Callees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Callee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Callee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Callee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Callers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Callers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Callers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callers = append(m.Callers, &Caller{})
+			if err := m.Callers[len(m.Callers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Caller) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Caller: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Caller: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Desc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallStack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallStack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallStack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callers = append(m.Callers, Caller{})
+			if err := m.Callers[len(m.Callers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozSerial(x uint64) (n int) {
-	return sovSerial(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Location) Unmarshal(dAtA []byte) error {
+func (m *FreeVars) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2943,17 +6823,17 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Location: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreeVars: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Location: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreeVars: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeVar", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -2963,26 +6843,28 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.FreeVar = append(m.FreeVar, FreeVar{})
+			if err := m.FreeVar[len(m.FreeVar)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
 			}
-			m.Line = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -2992,16 +6874,67 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Line |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Col = 0
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreeVar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreeVar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreeVar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3011,14 +6944,24 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Col |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3043,13 +6986,13 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3059,24 +7002,49 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Options == nil {
-				m.Options = &Options{}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3099,7 +7067,7 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Options) Unmarshal(dAtA []byte) error {
+func (m *Implements) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3122,17 +7090,17 @@ func (m *Options) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Options: wiretype end group for non-group")
+			return fmt.Errorf("proto: Implements: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Options: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Implements: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field T", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3142,76 +7110,58 @@ func (m *Options) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scope = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSerial(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.T.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthSerial
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignableTo", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Peers) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSerial
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Peers: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Peers: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.AssignableTo = append(m.AssignableTo, ImplementsType{})
+			if err := m.AssignableTo[len(m.AssignableTo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignableFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3221,26 +7171,28 @@ func (m *Peers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.AssignableFrom = append(m.AssignableFrom, ImplementsType{})
+			if err := m.AssignableFrom[len(m.AssignableFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignableFromPtr", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3250,26 +7202,28 @@ func (m *Peers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.AssignableFromPtr = append(m.AssignableFromPtr, ImplementsType{})
+			if err := m.AssignableFromPtr[len(m.AssignableFromPtr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3279,26 +7233,30 @@ func (m *Peers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allocs = append(m.Allocs, string(dAtA[iNdEx:postIndex]))
+			if m.Method == nil {
+				m.Method = &DescribeMethod{}
+			}
+			if err := m.Method.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sends", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignableToMethod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3308,26 +7266,28 @@ func (m *Peers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sends = append(m.Sends, string(dAtA[iNdEx:postIndex]))
+			m.AssignableToMethod = append(m.AssignableToMethod, DescribeMethod{})
+			if err := m.AssignableToMethod[len(m.AssignableToMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignableFromMethod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3337,26 +7297,28 @@ func (m *Peers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receives = append(m.Receives, string(dAtA[iNdEx:postIndex]))
+			m.AssignableFromMethod = append(m.AssignableFromMethod, DescribeMethod{})
+			if err := m.AssignableFromMethod[len(m.AssignableFromMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Closes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignableFromPtrMethod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3366,21 +7328,43 @@ func (m *Peers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Closes = append(m.Closes, string(dAtA[iNdEx:postIndex]))
+			m.AssignableFromPtrMethod = append(m.AssignableFromPtrMethod, DescribeMethod{})
+			if err := m.AssignableFromPtrMethod[len(m.AssignableFromPtrMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -3402,7 +7386,7 @@ func (m *Peers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReferrersInitial) Unmarshal(dAtA []byte) error {
+func (m *ImplementsType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3425,15 +7409,15 @@ func (m *ReferrersInitial) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReferrersInitial: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImplementsType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReferrersInitial: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImplementsType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjPos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3458,11 +7442,11 @@ func (m *ReferrersInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjPos = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3487,61 +7471,11 @@ func (m *ReferrersInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Desc = string(dAtA[iNdEx:postIndex])
+			m.Pos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSerial(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSerial
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReferrersPackage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSerial
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReferrersPackage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReferrersPackage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3566,38 +7500,7 @@ func (m *ReferrersPackage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Package = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSerial
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSerial
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Refs = append(m.Refs, Ref{})
-			if err := m.Refs[len(m.Refs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3620,7 +7523,7 @@ func (m *ReferrersPackage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Ref) Unmarshal(dAtA []byte) error {
+func (m *SyntaxNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3643,15 +7546,15 @@ func (m *Ref) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Ref: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyntaxNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ref: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyntaxNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3676,13 +7579,32 @@ func (m *Ref) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3692,21 +7614,11 @@ func (m *Ref) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.End |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSerial
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Text = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -3728,7 +7640,7 @@ func (m *Ref) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Definition) Unmarshal(dAtA []byte) error {
+func (m *What) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3751,17 +7663,17 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Definition: wiretype end group for non-group")
+			return fmt.Errorf("proto: What: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Definition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: What: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjPos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enclosing", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3771,24 +7683,26 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjPos = string(dAtA[iNdEx:postIndex])
+			m.Enclosing = append(m.Enclosing, SyntaxNode{})
+			if err := m.Enclosing[len(m.Enclosing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3813,61 +7727,40 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Desc = string(dAtA[iNdEx:postIndex])
+			m.Modes = append(m.Modes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSerial(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthSerial
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcDir", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Callees) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSerial
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Callees: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Callees: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SrcDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3892,11 +7785,11 @@ func (m *Callees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.ImportPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3921,13 +7814,13 @@ func (m *Callees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Desc = string(dAtA[iNdEx:postIndex])
+			m.Object = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SameIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -3937,23 +7830,41 @@ func (m *Callees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Callees = append(m.Callees, &Callee{})
-			if err := m.Callees[len(m.Callees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SameIDs = append(m.SameIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -3975,7 +7886,7 @@ func (m *Callees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Callee) Unmarshal(dAtA []byte) error {
+func (m *PointsToLabel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3998,15 +7909,15 @@ func (m *Callee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Callee: wiretype end group for non-group")
+			return fmt.Errorf("proto: PointsToLabel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Callee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PointsToLabel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4031,11 +7942,11 @@ func (m *Callee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Pos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4060,7 +7971,7 @@ func (m *Callee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.Desc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4083,7 +7994,7 @@ func (m *Callee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Callers) Unmarshal(dAtA []byte) error {
+func (m *PointsTos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4106,15 +8017,15 @@ func (m *Callers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Callers: wiretype end group for non-group")
+			return fmt.Errorf("proto: PointsTos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Callers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PointsTos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsTos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4138,11 +8049,31 @@ func (m *Callers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Callers = append(m.Callers, &Caller{})
-			if err := m.Callers[len(m.Callers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PointsTos = append(m.PointsTos, PointsTo{})
+			if err := m.PointsTos[len(m.PointsTos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -4164,7 +8095,7 @@ func (m *Callers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Caller) Unmarshal(dAtA []byte) error {
+func (m *PointsTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4187,15 +8118,15 @@ func (m *Caller) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Caller: wiretype end group for non-group")
+			return fmt.Errorf("proto: PointsTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Caller: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PointsTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4220,11 +8151,11 @@ func (m *Caller) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4249,13 +8180,13 @@ func (m *Caller) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Desc = string(dAtA[iNdEx:postIndex])
+			m.NamePos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4265,20 +8196,22 @@ func (m *Caller) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
+			m.Labels = append(m.Labels, PointsToLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4301,7 +8234,7 @@ func (m *Caller) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallStack) Unmarshal(dAtA []byte) error {
+func (m *DescribeValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4324,15 +8257,15 @@ func (m *CallStack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallStack: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallStack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4357,11 +8290,11 @@ func (m *CallStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4386,13 +8319,13 @@ func (m *CallStack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjPos", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4402,22 +8335,20 @@ func (m *CallStack) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Callers = append(m.Callers, Caller{})
-			if err := m.Callers[len(m.Callers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjPos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4440,7 +8371,7 @@ func (m *CallStack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreeVars) Unmarshal(dAtA []byte) error {
+func (m *DescribeMethod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4463,17 +8394,17 @@ func (m *FreeVars) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreeVars: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeMethod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreeVars: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeMethod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreeVar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4483,22 +8414,49 @@ func (m *FreeVars) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FreeVar = append(m.FreeVar, FreeVar{})
-			if err := m.FreeVar[len(m.FreeVar)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4521,7 +8479,7 @@ func (m *FreeVars) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreeVar) Unmarshal(dAtA []byte) error {
+func (m *DescribeType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4544,15 +8502,15 @@ func (m *FreeVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreeVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreeVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4577,11 +8535,11 @@ func (m *FreeVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4606,11 +8564,11 @@ func (m *FreeVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.NamePos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NameDef", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4635,13 +8593,13 @@ func (m *FreeVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ref = string(dAtA[iNdEx:postIndex])
+			m.NameDef = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4651,20 +8609,22 @@ func (m *FreeVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Methods = append(m.Methods, DescribeMethod{})
+			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4687,7 +8647,7 @@ func (m *FreeVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Implements) Unmarshal(dAtA []byte) error {
+func (m *DescribeMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4710,17 +8670,17 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Implements: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Implements: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field T", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4730,27 +8690,26 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.T.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignableTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4760,28 +8719,26 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignableTo = append(m.AssignableTo, ImplementsType{})
-			if err := m.AssignableTo[len(m.AssignableTo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignableFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4791,28 +8748,26 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignableFrom = append(m.AssignableFrom, ImplementsType{})
-			if err := m.AssignableFrom[len(m.AssignableFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignableFromPtr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4822,28 +8777,26 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignableFromPtr = append(m.AssignableFromPtr, ImplementsType{})
-			if err := m.AssignableFromPtr[len(m.AssignableFromPtr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4853,28 +8806,24 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Method == nil {
-				m.Method = &DescribeMethod{}
-			}
-			if err := m.Method.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignableToMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4898,16 +8847,66 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignableToMethod = append(m.AssignableToMethod, DescribeMethod{})
-			if err := m.AssignableToMethod[len(m.AssignableToMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Methods = append(m.Methods, DescribeMethod{})
+			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribePackage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribePackage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribePackage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignableFromMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -4917,26 +8916,24 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignableFromMethod = append(m.AssignableFromMethod, DescribeMethod{})
-			if err := m.AssignableFromMethod[len(m.AssignableFromMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignableFromPtrMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4959,9 +8956,9 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
-			}
-			m.AssignableFromPtrMethod = append(m.AssignableFromPtrMethod, DescribeMethod{})
-			if err := m.AssignableFromPtrMethod[len(m.AssignableFromPtrMethod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			}
+			m.Members = append(m.Members, &DescribeMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4986,7 +8983,7 @@ func (m *Implements) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImplementsType) Unmarshal(dAtA []byte) error {
+func (m *Describe) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5009,15 +9006,15 @@ func (m *ImplementsType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImplementsType: wiretype end group for non-group")
+			return fmt.Errorf("proto: Describe: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImplementsType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Describe: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5042,7 +9039,7 @@ func (m *ImplementsType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Desc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5075,7 +9072,7 @@ func (m *ImplementsType) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5100,63 +9097,46 @@ func (m *ImplementsType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Detail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSerial(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyntaxNode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSerial
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Package == nil {
+				m.Package = &DescribePackage{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Package.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyntaxNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyntaxNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -5166,26 +9146,30 @@ func (m *SyntaxNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Type == nil {
+				m.Type = &DescribeType{}
+			}
+			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Start = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -5195,16 +9179,30 @@ func (m *SyntaxNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Start |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &DescribeValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
 			}
-			m.End = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -5214,11 +9212,12 @@ func (m *SyntaxNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.End |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Cached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -5240,7 +9239,7 @@ func (m *SyntaxNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *What) Unmarshal(dAtA []byte) error {
+func (m *WhichErrs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5263,17 +9262,17 @@ func (m *What) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: What: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhichErrs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: What: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhichErrs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enclosing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrPos", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -5283,26 +9282,24 @@ func (m *What) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enclosing = append(m.Enclosing, SyntaxNode{})
-			if err := m.Enclosing[len(m.Enclosing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ErrPos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Globals", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5327,11 +9324,11 @@ func (m *What) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Modes = append(m.Modes, string(dAtA[iNdEx:postIndex]))
+			m.Globals = append(m.Globals, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constants", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5356,13 +9353,13 @@ func (m *What) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcDir = string(dAtA[iNdEx:postIndex])
+			m.Constants = append(m.Constants, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImportPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -5372,24 +9369,96 @@ func (m *What) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImportPath = string(dAtA[iNdEx:postIndex])
+			m.Types = append(m.Types, WhichErrsType{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSerial
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhichErrsType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhichErrsType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhichErrsType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5414,11 +9483,11 @@ func (m *What) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Object = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SameIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5443,7 +9512,7 @@ func (m *What) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SameIDs = append(m.SameIDs, string(dAtA[iNdEx:postIndex]))
+			m.Position = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5466,7 +9535,7 @@ func (m *What) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PointsToLabel) Unmarshal(dAtA []byte) error {
+func (m *Query) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5489,15 +9558,15 @@ func (m *PointsToLabel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PointsToLabel: wiretype end group for non-group")
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PointsToLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5522,11 +9591,44 @@ func (m *PointsToLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Location == nil {
+				m.Location = &Location{}
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5551,7 +9653,7 @@ func (m *PointsToLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Desc = string(dAtA[iNdEx:postIndex])
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5574,7 +9676,7 @@ func (m *PointsToLabel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PointsTos) Unmarshal(dAtA []byte) error {
+func (m *Output) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5597,17 +9699,17 @@ func (m *PointsTos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PointsTos: wiretype end group for non-group")
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PointsTos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointsTos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -5617,23 +9719,43 @@ func (m *PointsTos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PointsTos = append(m.PointsTos, PointsTo{})
-			if err := m.PointsTos[len(m.PointsTos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Text = append(m.Text[:0], dAtA[iNdEx:postIndex]...)
+			if m.Text == nil {
+				m.Text = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -5655,7 +9777,7 @@ func (m *PointsTos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PointsTo) Unmarshal(dAtA []byte) error {
+func (m *BatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5678,17 +9800,17 @@ func (m *PointsTo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PointsTo: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PointsTo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -5698,26 +9820,28 @@ func (m *PointsTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, BatchItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamePos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -5727,24 +9851,28 @@ func (m *PointsTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamePos = string(dAtA[iNdEx:postIndex])
+			if m.Options == nil {
+				m.Options = &Options{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overlays", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5768,11 +9896,40 @@ func (m *PointsTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, PointsToLabel{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Overlays = append(m.Overlays, Overlay{})
+			if err := m.Overlays[len(m.Overlays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -5794,7 +9951,7 @@ func (m *PointsTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeValue) Unmarshal(dAtA []byte) error {
+func (m *BatchItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5817,15 +9974,15 @@ func (m *DescribeValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5850,40 +10007,11 @@ func (m *DescribeValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSerial
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSerial
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjPos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5908,7 +10036,7 @@ func (m *DescribeValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjPos = string(dAtA[iNdEx:postIndex])
+			m.Pos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5931,7 +10059,7 @@ func (m *DescribeValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMethod) Unmarshal(dAtA []byte) error {
+func (m *BatchResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5954,17 +10082,36 @@ func (m *DescribeMethod) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMethod: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -5974,24 +10121,45 @@ func (m *DescribeMethod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Text = append(m.Text[:0], dAtA[iNdEx:postIndex]...)
+			if m.Text == nil {
+				m.Text = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6016,8 +10184,61 @@ func (m *DescribeMethod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &ErrorDetails{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cached = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -6039,7 +10260,7 @@ func (m *DescribeMethod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeType) Unmarshal(dAtA []byte) error {
+func (m *DaemonStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6062,15 +10283,15 @@ func (m *DescribeType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeType: wiretype end group for non-group")
+			return fmt.Errorf("proto: DaemonStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DaemonStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6095,11 +10316,11 @@ func (m *DescribeType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamePos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6124,13 +10345,89 @@ func (m *DescribeType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamePos = string(dAtA[iNdEx:postIndex])
+			m.GoVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pid |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			m.Uptime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uptime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeapAlloc", wireType)
+			}
+			m.HeapAlloc = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeapAlloc |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeapSys", wireType)
+			}
+			m.HeapSys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeapSys |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workspaces", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -6140,24 +10437,26 @@ func (m *DescribeType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NameDef = string(dAtA[iNdEx:postIndex])
+			m.Workspaces = append(m.Workspaces, WorkspaceStatus{})
+			if err := m.Workspaces[len(m.Workspaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6181,11 +10480,30 @@ func (m *DescribeType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Methods = append(m.Methods, DescribeMethod{})
-			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Calls = append(m.Calls, CallStatus{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coalesced", wireType)
+			}
+			m.Coalesced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Coalesced |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -6207,7 +10525,7 @@ func (m *DescribeType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMember) Unmarshal(dAtA []byte) error {
+func (m *WorkspaceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6230,15 +10548,15 @@ func (m *DescribeMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkspaceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkspaceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GOROOT", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6263,11 +10581,11 @@ func (m *DescribeMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.GOROOT = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GOPATH", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6292,11 +10610,11 @@ func (m *DescribeMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.GOPATH = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GOOS", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6321,11 +10639,11 @@ func (m *DescribeMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.GOOS = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GOARCH", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6350,11 +10668,11 @@ func (m *DescribeMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
+			m.GOARCH = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6379,11 +10697,31 @@ func (m *DescribeMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.BuildTags = append(m.BuildTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CgoEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CgoEnabled = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Programs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6407,66 +10745,153 @@ func (m *DescribeMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Methods = append(m.Methods, DescribeMethod{})
-			if err := m.Methods[len(m.Methods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Programs = append(m.Programs, ProgramStatus{})
+			if err := m.Programs[len(m.Programs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSerial(dAtA[iNdEx:])
-			if err != nil {
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProgramCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reloads", wireType)
+			}
+			m.Reloads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reloads |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebuilt", wireType)
+			}
+			m.Rebuilt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rebuilt |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribePackage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSerial
+			if err := m.AnalysisCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedFiles", wireType)
+			}
+			m.IndexedFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedFiles |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexing", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribePackage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribePackage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Indexing = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -6476,24 +10901,25 @@ func (m *DescribePackage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultCache", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6517,8 +10943,7 @@ func (m *DescribePackage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &DescribeMember{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResultCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6543,7 +10968,7 @@ func (m *DescribePackage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Describe) Unmarshal(dAtA []byte) error {
+func (m *ProgramStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6566,15 +10991,15 @@ func (m *Describe) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Describe: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProgramStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Describe: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProgramStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6599,13 +11024,13 @@ func (m *Describe) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Desc = string(dAtA[iNdEx:postIndex])
+			m.Scope = append(m.Scope, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packages", wireType)
 			}
-			var stringLen uint64
+			m.Packages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -6615,26 +11040,16 @@ func (m *Describe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Packages |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSerial
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pos = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loading", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -6644,26 +11059,17 @@ func (m *Describe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSerial
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Loading = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSA", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -6673,30 +11079,17 @@ func (m *Describe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSerial
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Package == nil {
-				m.Package = &DescribePackage{}
-			}
-			if err := m.Package.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.SSA = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerAnalysis", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -6706,30 +11099,67 @@ func (m *Describe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.PointerAnalysis = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerial(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthSerial
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Type == nil {
-				m.Type = &DescribeType{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerial
 			}
-			if err := m.Type.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
+			}
+			m.Hits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -6739,25 +11169,30 @@ func (m *Describe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Hits |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSerial
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Value == nil {
-				m.Value = &DescribeValue{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -6779,7 +11214,7 @@ func (m *Describe) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhichErrs) Unmarshal(dAtA []byte) error {
+func (m *CallStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6802,15 +11237,15 @@ func (m *WhichErrs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhichErrs: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhichErrs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrPos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6835,11 +11270,11 @@ func (m *WhichErrs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrPos = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Globals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6864,11 +11299,11 @@ func (m *WhichErrs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Globals = append(m.Globals, string(dAtA[iNdEx:postIndex]))
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6893,13 +11328,13 @@ func (m *WhichErrs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Constants = string(dAtA[iNdEx:postIndex])
+			m.Pos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elapsed", wireType)
 			}
-			var msglen int
+			m.Elapsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSerial
@@ -6909,23 +11344,11 @@ func (m *WhichErrs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Elapsed |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSerial
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Types = append(m.Types, WhichErrsType{})
-			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSerial(dAtA[iNdEx:])
@@ -6947,7 +11370,7 @@ func (m *WhichErrs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhichErrsType) Unmarshal(dAtA []byte) error {
+func (m *ErrorDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6970,15 +11393,15 @@ func (m *WhichErrsType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhichErrsType: wiretype end group for non-group")
+			return fmt.Errorf("proto: ErrorDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhichErrsType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packages", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7003,11 +11426,11 @@ func (m *WhichErrsType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Packages = append(m.Packages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7032,7 +11455,36 @@ func (m *WhichErrsType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Position = string(dAtA[iNdEx:postIndex])
+			m.Pos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerial
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSerial
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modes = append(m.Modes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7263,92 +11715,148 @@ var (
 func init() { proto.RegisterFile("serial/serial.proto", fileDescriptorSerial) }
 
 var fileDescriptorSerial = []byte{
-	// 1386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xdb, 0x6e, 0x1c, 0x45,
-	0x13, 0xde, 0xc9, 0x9e, 0xbc, 0xb5, 0x3e, 0xa5, 0x7f, 0xc7, 0x59, 0x59, 0xbf, 0x1c, 0xab, 0x25,
-	0xa4, 0x05, 0x2b, 0x36, 0xb1, 0x93, 0x80, 0x04, 0x02, 0x92, 0xac, 0x63, 0x25, 0xe4, 0xb0, 0x8c,
-	0x8d, 0x23, 0x2e, 0x67, 0xc7, 0xb5, 0xeb, 0x21, 0xb3, 0xd3, 0x4b, 0x77, 0x1b, 0x25, 0x8f, 0xc0,
-	0x0d, 0xf0, 0x20, 0x5c, 0x21, 0xae, 0xb9, 0xce, 0x25, 0xe2, 0x01, 0x10, 0x84, 0x17, 0x41, 0x7d,
-	0x9c, 0xd9, 0x43, 0x36, 0xce, 0xd5, 0x76, 0x75, 0xd5, 0x57, 0xf5, 0x55, 0x4d, 0x75, 0x75, 0x2f,
-	0xfc, 0x4f, 0x20, 0x4f, 0xa2, 0x74, 0xd7, 0xfc, 0xec, 0x8c, 0x38, 0x93, 0x8c, 0xd4, 0x8c, 0xb4,
-	0x71, 0x7d, 0x90, 0xc8, 0xb3, 0xf3, 0xde, 0x4e, 0xcc, 0x86, 0xbb, 0x03, 0x36, 0x60, 0xbb, 0x5a,
-	0xdd, 0x3b, 0xef, 0x6b, 0x49, 0x0b, 0x7a, 0x65, 0x60, 0xf4, 0x87, 0x00, 0x16, 0x1e, 0xb1, 0x38,
-	0x92, 0x09, 0xcb, 0xc8, 0x06, 0x2c, 0xf4, 0x93, 0x14, 0xb3, 0x68, 0x88, 0xad, 0x60, 0x2b, 0x68,
-	0x37, 0x42, 0x2f, 0x13, 0x02, 0x95, 0x34, 0xc9, 0xb0, 0x75, 0x69, 0x2b, 0x68, 0x97, 0x43, 0xbd,
-	0x26, 0xab, 0x50, 0x8e, 0x59, 0xda, 0x2a, 0xeb, 0x2d, 0xb5, 0x54, 0x3b, 0x23, 0x26, 0x5a, 0x15,
-	0x0d, 0x56, 0x4b, 0xf2, 0x3e, 0xd4, 0xd9, 0x48, 0x79, 0x17, 0xad, 0xea, 0x56, 0xd0, 0x6e, 0xee,
-	0xad, 0xec, 0x58, 0xde, 0x4f, 0xcd, 0x76, 0xe8, 0xf4, 0xf4, 0x1a, 0xd4, 0xed, 0x1e, 0x59, 0x83,
-	0xea, 0x51, 0xcc, 0x46, 0x8e, 0x86, 0x11, 0xe8, 0x4f, 0x01, 0x54, 0xbb, 0x88, 0x5c, 0xa8, 0x38,
-	0x5d, 0x26, 0xac, 0x56, 0x2d, 0x15, 0xbf, 0xe3, 0x97, 0x23, 0xc3, 0xaf, 0x11, 0xea, 0x35, 0x59,
-	0x87, 0xda, 0x9d, 0x34, 0x65, 0xb1, 0x68, 0x95, 0xb7, 0xca, 0xed, 0x46, 0x68, 0x25, 0xed, 0x1d,
-	0xb3, 0x53, 0xc5, 0xb3, 0xac, 0xbd, 0x2b, 0x41, 0x65, 0x1f, 0x62, 0x8c, 0xc9, 0xf7, 0xa8, 0xa8,
-	0x2a, 0x85, 0x97, 0x95, 0xa7, 0x7b, 0x29, 0x13, 0x28, 0x5a, 0x35, 0xe3, 0xc9, 0x48, 0xf4, 0x33,
-	0x58, 0x0d, 0xb1, 0x8f, 0x9c, 0x23, 0x17, 0x0f, 0xb2, 0x44, 0x26, 0x51, 0xaa, 0x6c, 0x9f, 0xf6,
-	0xbe, 0xcd, 0xe9, 0x59, 0x49, 0x31, 0xec, 0xa0, 0x88, 0x1d, 0x43, 0xb5, 0xa6, 0x47, 0x05, 0x7c,
-	0x37, 0x8a, 0x9f, 0x47, 0x03, 0x24, 0x2d, 0xa8, 0xdb, 0xa5, 0x75, 0xe0, 0x44, 0xf2, 0x1e, 0x54,
-	0x42, 0xec, 0x8b, 0xd6, 0xa5, 0xad, 0x72, 0xbb, 0xb9, 0xd7, 0x74, 0x85, 0x0c, 0xb1, 0x7f, 0xb7,
-	0xf2, 0xea, 0xaf, 0x6b, 0xa5, 0x50, 0xab, 0xe9, 0x36, 0x94, 0x43, 0xec, 0xbf, 0xa1, 0x46, 0xf8,
-	0x42, 0xfa, 0x1a, 0xe1, 0x0b, 0x49, 0x3f, 0x06, 0xe8, 0x60, 0x3f, 0x51, 0xdc, 0x59, 0xf6, 0x4e,
-	0xdc, 0xbf, 0x81, 0xfa, 0xbd, 0x28, 0x4d, 0x11, 0xdf, 0xf0, 0x39, 0x26, 0x01, 0xa4, 0xed, 0x01,
-	0xfa, 0x7b, 0x34, 0xf7, 0x96, 0x5d, 0x06, 0x66, 0x3b, 0x74, 0x6a, 0xba, 0x03, 0x35, 0xb3, 0x54,
-	0x7e, 0x9e, 0xe4, 0xed, 0xa8, 0xd7, 0x2e, 0xda, 0x25, 0x1f, 0x8d, 0xee, 0x5b, 0xcf, 0x5c, 0xf8,
-	0x20, 0x5c, 0xd1, 0x99, 0x0e, 0xc2, 0x43, 0xa7, 0xa6, 0xf7, 0x6d, 0x10, 0x7e, 0x41, 0xfa, 0xeb,
-	0xce, 0x5e, 0x37, 0xbc, 0xea, 0x01, 0x2d, 0x51, 0x84, 0x86, 0x5a, 0x1d, 0xc9, 0x28, 0x7e, 0x3e,
-	0xc3, 0xd5, 0x3a, 0xd4, 0x8e, 0x23, 0x3e, 0x40, 0x57, 0x76, 0x2b, 0x91, 0x9d, 0x9c, 0xe8, 0xac,
-	0x6a, 0x70, 0xfb, 0x49, 0x3d, 0xdd, 0x4f, 0x60, 0xe1, 0x3e, 0x47, 0x3c, 0x89, 0xb8, 0x20, 0xbb,
-	0x50, 0xb7, 0x6b, 0x9b, 0xa4, 0x3f, 0x54, 0x76, 0xdb, 0x81, 0xad, 0x48, 0xbf, 0xf6, 0x80, 0xd9,
-	0xc9, 0x7e, 0x99, 0x64, 0xa7, 0x2e, 0x59, 0xb5, 0x56, 0x56, 0x21, 0xf6, 0x6d, 0xa6, 0xba, 0x9d,
-	0xdc, 0x01, 0xab, 0xe4, 0x07, 0x8c, 0xfe, 0x56, 0x01, 0x78, 0x30, 0x1c, 0xa5, 0x38, 0xc4, 0x4c,
-	0x0a, 0xf2, 0x01, 0x04, 0xc7, 0xda, 0x71, 0x73, 0x6f, 0xdd, 0x11, 0xca, 0xd5, 0x0a, 0x61, 0x79,
-	0x05, 0xc7, 0xe4, 0x0b, 0x58, 0xbc, 0x23, 0x44, 0x32, 0xc8, 0xa2, 0x5e, 0x8a, 0xc7, 0xcc, 0xf6,
-	0xf4, 0x7c, 0xd8, 0x18, 0x82, 0x74, 0x60, 0x39, 0x97, 0xef, 0x73, 0x36, 0xb4, 0x75, 0x9c, 0xef,
-	0x63, 0x02, 0x43, 0x1e, 0xc2, 0xe5, 0xf1, 0x9d, 0xae, 0xe4, 0x7a, 0x2e, 0xbc, 0xcd, 0xd1, 0x34,
-	0x8c, 0xec, 0x40, 0xed, 0x31, 0xca, 0x33, 0x76, 0x6a, 0x47, 0x9d, 0x77, 0xa0, 0xfa, 0x87, 0x27,
-	0x3d, 0x34, 0xda, 0xd0, 0x5a, 0x91, 0x47, 0x40, 0x8a, 0x19, 0x59, 0x6c, 0x6d, 0x3c, 0xf8, 0x38,
-	0xd6, 0x06, 0x9f, 0x81, 0x23, 0x5d, 0x58, 0x1b, 0xa7, 0x64, 0xfd, 0xd5, 0x2f, 0xe0, 0x6f, 0x26,
-	0x92, 0x9c, 0xc0, 0xd5, 0xa9, 0x24, 0xad, 0xd3, 0x85, 0x0b, 0x38, 0x7d, 0x13, 0x98, 0x3e, 0x84,
-	0xe5, 0xf1, 0x92, 0x5e, 0xec, 0x98, 0xfb, 0x46, 0x2d, 0xe7, 0x8d, 0x4a, 0x4f, 0x00, 0x8e, 0x5e,
-	0x66, 0x32, 0x7a, 0xf1, 0x84, 0x9d, 0x22, 0xd9, 0x82, 0xa6, 0xa1, 0xa2, 0xef, 0x11, 0xeb, 0xae,
-	0xb8, 0xa5, 0x67, 0xbf, 0x8c, 0xb8, 0x39, 0x8d, 0xd5, 0xd0, 0x08, 0x2a, 0xd6, 0x81, 0x75, 0x5c,
-	0x0d, 0xd5, 0x92, 0xfe, 0x1e, 0x40, 0xe5, 0xd9, 0x59, 0x24, 0xc9, 0x6d, 0x68, 0x1c, 0x64, 0x71,
-	0xca, 0x44, 0x92, 0x0d, 0xec, 0x69, 0x23, 0x2e, 0xed, 0x3c, 0xb2, 0x4d, 0x39, 0x37, 0x55, 0x81,
-	0x1e, 0xb3, 0x53, 0x34, 0xd3, 0xba, 0x11, 0x1a, 0x41, 0x4d, 0x83, 0x23, 0x1e, 0x77, 0x12, 0x3f,
-	0x44, 0x8c, 0x44, 0x36, 0xf5, 0x41, 0x62, 0x5c, 0x76, 0x23, 0x79, 0x66, 0xcf, 0x58, 0x61, 0xc7,
-	0x0e, 0x66, 0x8c, 0xa5, 0x6e, 0x2d, 0x33, 0x98, 0x31, 0x96, 0xea, 0xb2, 0x38, 0x8a, 0x86, 0xf8,
-	0xa0, 0xe3, 0x6e, 0x26, 0x27, 0xd2, 0x5b, 0xb0, 0xd4, 0x65, 0x89, 0x2a, 0x30, 0x7b, 0x14, 0xf5,
-	0x30, 0xbd, 0xd8, 0x94, 0xa3, 0x77, 0xa0, 0xe1, 0x60, 0x82, 0xdc, 0x2c, 0x08, 0x36, 0xf7, 0x55,
-	0x97, 0xbb, 0x53, 0xb8, 0xcc, 0xbd, 0x21, 0x1d, 0xc2, 0x82, 0x13, 0xfc, 0xd4, 0x08, 0x0a, 0xd7,
-	0x72, 0x0b, 0xea, 0xea, 0x03, 0xe7, 0x1f, 0xd7, 0x89, 0x64, 0x1f, 0x6a, 0x9a, 0xab, 0x1b, 0x89,
-	0x57, 0x26, 0x83, 0x69, 0xad, 0x8d, 0x68, 0x4d, 0xe9, 0x57, 0xb0, 0xe4, 0xda, 0xef, 0x24, 0x4a,
-	0xcf, 0x71, 0x66, 0xcc, 0x35, 0xa8, 0x6a, 0xa5, 0x8d, 0x68, 0x84, 0xc2, 0x75, 0x57, 0x2e, 0x5e,
-	0x77, 0xf4, 0x36, 0x2c, 0x8f, 0x77, 0xf4, 0xbc, 0x06, 0x2d, 0xe7, 0xf7, 0xd0, 0x8f, 0x01, 0x2c,
-	0x3a, 0xa0, 0xeb, 0xeb, 0x77, 0x48, 0xdf, 0x6a, 0x3a, 0x7e, 0xf0, 0x3a, 0x91, 0xdc, 0x86, 0xba,
-	0x21, 0x22, 0x26, 0x67, 0xd3, 0xcc, 0x93, 0xe7, 0x8c, 0xe9, 0x2f, 0x41, 0x31, 0x93, 0x61, 0x0f,
-	0xf9, 0xcc, 0x4c, 0x66, 0x3d, 0x9e, 0x7c, 0xc5, 0xca, 0xc5, 0x8a, 0xd9, 0x9c, 0x2b, 0xd3, 0x87,
-	0xb2, 0x5a, 0xb8, 0x3d, 0x0a, 0x74, 0x6b, 0xef, 0x42, 0xf7, 0x19, 0xac, 0x38, 0x03, 0xf7, 0xe6,
-	0x21, 0x50, 0xd1, 0x47, 0xc2, 0xd2, 0xd5, 0x87, 0xe1, 0x43, 0xe5, 0x5e, 0x25, 0x23, 0x26, 0xaf,
-	0x8d, 0xf1, 0x5c, 0x43, 0x67, 0x46, 0xff, 0x0c, 0x60, 0xc1, 0xe9, 0x7c, 0xdb, 0x07, 0x85, 0xcb,
-	0x7d, 0x7a, 0xd8, 0xac, 0x43, 0xad, 0x83, 0x32, 0x4a, 0x52, 0xd7, 0x1b, 0x46, 0x22, 0x37, 0xf2,
-	0xe7, 0x59, 0x45, 0x4f, 0xf9, 0xab, 0x93, 0xc1, 0xad, 0x3a, 0x7f, 0xb7, 0xb5, 0x6d, 0x79, 0xcd,
-	0xad, 0xb0, 0x36, 0x69, 0xaf, 0x74, 0xb6, 0xe8, 0xdb, 0xae, 0xe8, 0x35, 0x6d, 0x7a, 0x65, 0xd2,
-	0x54, 0x2b, 0xed, 0xb7, 0x50, 0xdd, 0xd6, 0x78, 0x76, 0x96, 0xc4, 0x67, 0x07, 0x9c, 0x6b, 0xbe,
-	0x07, 0x9c, 0x17, 0x9e, 0x6e, 0x46, 0x52, 0x4d, 0x75, 0x98, 0xb2, 0x5e, 0x94, 0xba, 0x49, 0xe4,
-	0x44, 0xf2, 0x7f, 0x68, 0xdc, 0x63, 0x99, 0x90, 0x51, 0x26, 0x5d, 0x17, 0xe7, 0x1b, 0xe4, 0x06,
-	0x54, 0x15, 0x25, 0xd7, 0x70, 0x9e, 0x8a, 0x8f, 0x58, 0xb8, 0x0b, 0x8d, 0x25, 0xfd, 0x1c, 0x96,
-	0xc6, 0xb4, 0x33, 0xdb, 0x7f, 0x43, 0x4d, 0x07, 0xa1, 0x9f, 0x9b, 0xb6, 0xdc, 0x5e, 0xa6, 0x0d,
-	0xa8, 0x87, 0xf8, 0xdd, 0x39, 0x0a, 0x49, 0x41, 0xbd, 0xc6, 0xc5, 0x88, 0x65, 0x02, 0xf7, 0x7e,
-	0xad, 0x42, 0xf9, 0x90, 0x9d, 0x92, 0x6d, 0xa8, 0x74, 0xd5, 0x68, 0x5d, 0xc9, 0x5f, 0xbe, 0xda,
-	0x78, 0x63, 0x35, 0xdf, 0x30, 0x10, 0x5a, 0x22, 0x37, 0x00, 0x0e, 0x51, 0xfa, 0x17, 0xaa, 0xb3,
-	0x70, 0x7f, 0x76, 0x36, 0x56, 0xc6, 0x1f, 0x9f, 0x62, 0x1c, 0xc2, 0xdf, 0x0e, 0xe1, 0x0a, 0x72,
-	0x0b, 0x16, 0x2d, 0xc4, 0xbe, 0xff, 0xa6, 0x40, 0x97, 0x8b, 0x20, 0x6d, 0x44, 0x4b, 0xe4, 0x23,
-	0x58, 0x3a, 0x44, 0x59, 0x78, 0x78, 0x4f, 0xe3, 0x48, 0xfe, 0xed, 0x9d, 0x15, 0x2d, 0x91, 0x7d,
-	0x68, 0x6a, 0xa0, 0x6d, 0xe5, 0x69, 0xd8, 0xea, 0x64, 0xcb, 0x78, 0x90, 0x7f, 0x3d, 0xce, 0x01,
-	0x39, 0x1b, 0x4f, 0xb1, 0xf0, 0xba, 0x9b, 0x43, 0x31, 0xb7, 0xa2, 0x25, 0x72, 0x1d, 0x16, 0x0e,
-	0x51, 0xda, 0xff, 0x69, 0x53, 0x98, 0x25, 0x3f, 0xd2, 0xd1, 0x54, 0xf0, 0xa6, 0x26, 0xe7, 0x2f,
-	0x8c, 0x39, 0x05, 0xcc, 0x6f, 0x98, 0x12, 0xf9, 0x54, 0xd7, 0xdd, 0xff, 0x77, 0x9a, 0x01, 0x6b,
-	0x15, 0xfe, 0x1e, 0x8d, 0xfd, 0xc1, 0xa2, 0x25, 0xb2, 0x0d, 0xf5, 0x43, 0x94, 0xfa, 0x7a, 0x9f,
-	0x06, 0x2e, 0xe6, 0x9d, 0x1e, 0x49, 0xff, 0x89, 0xf3, 0x83, 0x36, 0x87, 0xa1, 0x37, 0xa2, 0xa5,
-	0xbb, 0x6b, 0xaf, 0xfe, 0xd9, 0x2c, 0xbd, 0x7a, 0xbd, 0x19, 0xfc, 0xf1, 0x7a, 0x33, 0xf8, 0xfb,
-	0xf5, 0x66, 0xf0, 0xf3, 0xbf, 0x9b, 0xa5, 0x5e, 0x4d, 0xff, 0xed, 0xde, 0xff, 0x2f, 0x00, 0x00,
-	0xff, 0xff, 0xc6, 0x7d, 0xda, 0x7d, 0xc4, 0x0f, 0x00, 0x00,
+	// 2276 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x59, 0x4b, 0x6f, 0x24, 0x49,
+	0x11, 0xee, 0x72, 0xf5, 0x33, 0xdc, 0x7e, 0x4c, 0xae, 0xf1, 0x96, 0x2c, 0x64, 0xac, 0x12, 0x48,
+	0xde, 0x9d, 0x1d, 0x7b, 0xed, 0x99, 0xf5, 0x22, 0xb4, 0x30, 0x78, 0xfc, 0x1a, 0xef, 0xce, 0x6c,
+	0xf7, 0x56, 0x7b, 0x3d, 0xe2, 0x58, 0x5d, 0x9d, 0x6e, 0x17, 0xae, 0xae, 0xec, 0xcd, 0xcc, 0x1e,
+	0xec, 0x03, 0x7f, 0x01, 0x71, 0xe3, 0x1f, 0x80, 0x90, 0xb8, 0x21, 0x2e, 0xc0, 0x0f, 0x98, 0x03,
+	0x07, 0x4e, 0x1c, 0x11, 0x0c, 0x37, 0xfe, 0x04, 0x28, 0x9f, 0x55, 0xd5, 0xaf, 0xf5, 0x9c, 0x3a,
+	0x23, 0x32, 0xbe, 0xcc, 0x88, 0xa8, 0xc8, 0xc8, 0xc8, 0x68, 0x78, 0x8f, 0x61, 0x1a, 0x87, 0xc9,
+	0xae, 0xfa, 0xd9, 0x19, 0x52, 0xc2, 0x09, 0xaa, 0x2a, 0x6a, 0xe3, 0x51, 0x3f, 0xe6, 0xd7, 0xa3,
+	0xee, 0x4e, 0x44, 0x06, 0xbb, 0x7d, 0xd2, 0x27, 0xbb, 0x72, 0xba, 0x3b, 0xba, 0x92, 0x94, 0x24,
+	0xe4, 0x48, 0xc1, 0xfc, 0xbf, 0x3a, 0x50, 0x7f, 0x41, 0xa2, 0x90, 0xc7, 0x24, 0x45, 0x1b, 0x50,
+	0xbf, 0x8a, 0x13, 0x9c, 0x86, 0x03, 0xec, 0x39, 0x5b, 0xce, 0x76, 0x23, 0xb0, 0x34, 0x42, 0x50,
+	0x4e, 0xe2, 0x14, 0x7b, 0x0b, 0x5b, 0xce, 0xb6, 0x1b, 0xc8, 0x31, 0x5a, 0x05, 0x37, 0x22, 0x89,
+	0xe7, 0x4a, 0x96, 0x18, 0x0a, 0xce, 0x90, 0x30, 0xaf, 0x2c, 0xc1, 0x62, 0x88, 0x3e, 0x80, 0x1a,
+	0x19, 0x8a, 0xd5, 0x99, 0x57, 0xd9, 0x72, 0xb6, 0x17, 0xf7, 0x57, 0x76, 0xb4, 0xde, 0x2d, 0xc5,
+	0x0e, 0xcc, 0x3c, 0xda, 0x83, 0x3a, 0x79, 0x8d, 0x69, 0x12, 0xde, 0x31, 0xaf, 0xba, 0xe5, 0x16,
+	0x64, 0x15, 0xff, 0x59, 0xf9, 0xcd, 0x3f, 0xbf, 0x57, 0x0a, 0xac, 0x98, 0xff, 0x14, 0x6a, 0x7a,
+	0x6a, 0xae, 0xf2, 0x1e, 0xd4, 0x22, 0x92, 0x72, 0x9c, 0x72, 0xa9, 0x7f, 0x33, 0x30, 0xa4, 0xff,
+	0x3f, 0x07, 0x6a, 0x5a, 0x11, 0xb4, 0x06, 0x95, 0x4e, 0x44, 0x86, 0x06, 0xae, 0x08, 0xb4, 0x0e,
+	0xd5, 0xb3, 0x56, 0xd0, 0x6a, 0x5d, 0x48, 0x68, 0x23, 0xd0, 0x94, 0xe2, 0xb7, 0x0f, 0x2f, 0x9e,
+	0x7b, 0xae, 0xe1, 0x0b, 0x4a, 0x38, 0xea, 0xac, 0xd5, 0xea, 0x68, 0x1f, 0xc8, 0xb1, 0x92, 0x3d,
+	0x0c, 0x8e, 0x9e, 0x7b, 0x15, 0x23, 0x2b, 0x28, 0xf4, 0x5d, 0x68, 0x3c, 0x1b, 0xc5, 0x49, 0xef,
+	0x22, 0xec, 0x2b, 0x93, 0x1b, 0x41, 0xc6, 0x40, 0x9b, 0x00, 0x47, 0x7d, 0x72, 0x92, 0x86, 0xdd,
+	0x04, 0xf7, 0xbc, 0xda, 0x96, 0xb3, 0x5d, 0x0f, 0x72, 0x1c, 0x31, 0x1f, 0xe0, 0xab, 0x04, 0x47,
+	0x42, 0x7d, 0xaf, 0xae, 0xe6, 0x33, 0x0e, 0xfa, 0x3e, 0x2c, 0x65, 0xd2, 0x1d, 0xcc, 0xbd, 0x86,
+	0x14, 0x29, 0x32, 0xfd, 0xdf, 0x39, 0x50, 0x69, 0x63, 0x4c, 0x99, 0xf8, 0x78, 0x6d, 0xc2, 0xb4,
+	0xf5, 0x62, 0x28, 0x6c, 0xb9, 0xb8, 0x1b, 0x62, 0x6d, 0xb9, 0x1c, 0x0b, 0x5b, 0x0e, 0x93, 0x84,
+	0x44, 0xcc, 0x73, 0xa5, 0xc2, 0x9a, 0x92, 0xde, 0xc3, 0x69, 0x4f, 0x7c, 0x7c, 0x57, 0x7a, 0x4f,
+	0x10, 0xe2, 0xab, 0x04, 0x38, 0xc2, 0xf1, 0x6b, 0x2c, 0xbe, 0xbf, 0x98, 0xb0, 0xb4, 0x58, 0xe9,
+	0x28, 0x21, 0x0c, 0x1b, 0xd3, 0x35, 0x25, 0xf9, 0x61, 0x74, 0x6d, 0x6d, 0xd6, 0x94, 0xff, 0x13,
+	0x58, 0x0d, 0xf0, 0x15, 0xa6, 0x14, 0x53, 0x76, 0x9e, 0xc6, 0x3c, 0x0e, 0x13, 0x21, 0xdb, 0xea,
+	0xfe, 0x3c, 0x53, 0x5b, 0x53, 0x42, 0xf3, 0x63, 0xcc, 0x22, 0xa3, 0xb9, 0x18, 0xfb, 0x37, 0x39,
+	0x7c, 0x3b, 0x8c, 0x6e, 0xc2, 0xbe, 0x8c, 0x0c, 0x3d, 0xd4, 0x0b, 0x18, 0x12, 0xfd, 0x00, 0xca,
+	0x01, 0xbe, 0x62, 0xde, 0x82, 0x8c, 0xc4, 0x45, 0x13, 0x89, 0x01, 0xbe, 0xd2, 0x51, 0x28, 0xa7,
+	0x73, 0xca, 0xba, 0x05, 0x65, 0x1f, 0x82, 0x1b, 0xe0, 0xab, 0x19, 0x3e, 0xc5, 0xb7, 0xdc, 0xfa,
+	0x14, 0xdf, 0x72, 0xff, 0x16, 0x96, 0xad, 0x66, 0x27, 0xaf, 0x71, 0xca, 0xd1, 0x3e, 0xd4, 0xb4,
+	0x89, 0x12, 0xbb, 0xb8, 0xef, 0xe5, 0x14, 0x28, 0xb8, 0x20, 0x30, 0x82, 0x02, 0x63, 0x6c, 0x59,
+	0x98, 0x81, 0xd1, 0xf3, 0xd6, 0x4a, 0xbf, 0x0d, 0x70, 0x8c, 0xaf, 0x62, 0xb1, 0x02, 0x49, 0xdf,
+	0xc5, 0x9b, 0x33, 0x0d, 0xff, 0x06, 0x6a, 0x47, 0x61, 0x92, 0x60, 0x3c, 0x23, 0xa0, 0x26, 0x16,
+	0xda, 0xb6, 0x00, 0x19, 0x51, 0x8b, 0xfb, 0xcb, 0x46, 0x6d, 0xc5, 0x0e, 0xec, 0x7a, 0xd9, 0x96,
+	0xe5, 0xc2, 0x96, 0x3b, 0x50, 0x55, 0x22, 0x62, 0xfd, 0x2f, 0xb3, 0x04, 0x20, 0xc7, 0x46, 0x8b,
+	0x05, 0xab, 0x85, 0xff, 0x85, 0xde, 0x91, 0x32, 0xbb, 0x39, 0x15, 0x6a, 0x4e, 0x6e, 0x4e, 0x03,
+	0x2b, 0x99, 0x6d, 0xbe, 0x50, 0xd8, 0xfc, 0x54, 0x6f, 0x4e, 0xef, 0x69, 0xee, 0xba, 0x91, 0x37,
+	0x79, 0x43, 0x51, 0xfe, 0x2f, 0xa1, 0x21, 0x46, 0x1d, 0x1e, 0x46, 0x37, 0x53, 0x96, 0x5a, 0x87,
+	0xea, 0x45, 0x48, 0xfb, 0xd8, 0x04, 0x8e, 0xa6, 0xd0, 0x4e, 0x66, 0xc0, 0x34, 0xef, 0x51, 0x1d,
+	0xac, 0x53, 0xcc, 0x28, 0xfa, 0xb0, 0x03, 0xf5, 0x53, 0x8a, 0xf1, 0x65, 0x48, 0x19, 0xda, 0x85,
+	0x9a, 0x1e, 0x7b, 0x4e, 0x31, 0x0f, 0x6b, 0xb6, 0x59, 0x54, 0x93, 0x33, 0x7d, 0xf3, 0xb5, 0x5d,
+	0x68, 0xba, 0x73, 0xbe, 0x88, 0xd3, 0x9e, 0x71, 0x8e, 0x18, 0x0b, 0xa9, 0x00, 0x5f, 0x69, 0xcf,
+	0x88, 0xa1, 0x4d, 0x41, 0xe5, 0x2c, 0x05, 0xf9, 0x7f, 0x2b, 0x03, 0x9c, 0x0f, 0x86, 0x09, 0x1e,
+	0xe0, 0x94, 0x33, 0xf4, 0x21, 0x38, 0x17, 0xfa, 0x94, 0xac, 0x1b, 0x45, 0xb3, 0x69, 0x81, 0xd0,
+	0xfa, 0x3a, 0x17, 0xe8, 0xa7, 0xd0, 0x3c, 0x64, 0x2c, 0xee, 0xcb, 0xfc, 0x77, 0x41, 0xf4, 0xe9,
+	0x9e, 0x0f, 0x2b, 0x20, 0xd0, 0x31, 0x2c, 0x67, 0xf4, 0x29, 0x25, 0x03, 0xcf, 0xbd, 0xc7, 0x1a,
+	0x63, 0x18, 0xf4, 0x39, 0x3c, 0x28, 0x72, 0xda, 0x9c, 0x7a, 0xe5, 0x7b, 0x2c, 0x34, 0x09, 0x43,
+	0x3b, 0x50, 0x7d, 0x89, 0xf9, 0x35, 0xe9, 0xe9, 0x1b, 0xd6, 0x2e, 0x20, 0xe2, 0x8d, 0xc6, 0x5d,
+	0xac, 0x66, 0x03, 0x2d, 0x85, 0x5e, 0x00, 0xca, 0x5b, 0xa4, 0xb1, 0xd5, 0x2d, 0x77, 0x36, 0x56,
+	0x6f, 0x3e, 0x05, 0x87, 0xda, 0xb0, 0x56, 0x54, 0x49, 0xaf, 0x57, 0xbb, 0xc7, 0x7a, 0x53, 0x91,
+	0xe8, 0x12, 0xde, 0x9f, 0x30, 0x52, 0x2f, 0x5a, 0xbf, 0xc7, 0xa2, 0xb3, 0xc0, 0xb9, 0x28, 0x6d,
+	0x14, 0xa2, 0xf4, 0x73, 0x58, 0x2e, 0xba, 0xfa, 0x7e, 0x69, 0xc4, 0x06, 0xb0, 0x9b, 0x05, 0xb0,
+	0x7f, 0x09, 0xd0, 0xb9, 0x4b, 0x79, 0x78, 0xfb, 0x25, 0xe9, 0x61, 0xb4, 0x05, 0x8b, 0x4a, 0x45,
+	0x59, 0x61, 0xe8, 0xe5, 0xf2, 0x2c, 0x79, 0x6b, 0xf2, 0x90, 0xaa, 0x53, 0x5d, 0x09, 0x14, 0x21,
+	0xf6, 0x3a, 0xd1, 0x0b, 0x57, 0x02, 0x31, 0xf4, 0xff, 0xe1, 0x40, 0xf9, 0xd5, 0x75, 0xc8, 0xd1,
+	0x01, 0x34, 0x4e, 0xd2, 0x28, 0x21, 0x2c, 0x4e, 0xfb, 0xfa, 0x74, 0x22, 0xe3, 0x8e, 0x6c, 0x67,
+	0xed, 0x8a, 0x4c, 0x54, 0x6c, 0xf4, 0x92, 0xf4, 0xb0, 0xba, 0xcf, 0x1a, 0x81, 0x22, 0x84, 0x4b,
+	0x3a, 0x34, 0x3a, 0x8e, 0x6d, 0x32, 0x52, 0x94, 0x28, 0x2d, 0xce, 0x07, 0x43, 0x42, 0x79, 0x3b,
+	0xe4, 0xd7, 0xfa, 0xec, 0xe5, 0x38, 0xfa, 0xa2, 0xc0, 0x11, 0x37, 0x05, 0x8d, 0xa2, 0xc4, 0x75,
+	0xda, 0x09, 0x07, 0xf8, 0xfc, 0xd8, 0xdc, 0xe9, 0x86, 0x9c, 0x79, 0xa9, 0x7f, 0x02, 0x4b, 0x6d,
+	0x12, 0x0b, 0xc7, 0x93, 0x17, 0x61, 0x17, 0x27, 0xf7, 0xcb, 0xa2, 0xfe, 0xcf, 0xa0, 0x61, 0x60,
+	0x0c, 0x3d, 0xc9, 0x11, 0xda, 0x27, 0xab, 0xc6, 0x27, 0x66, 0xc2, 0x78, 0x24, 0x43, 0xcd, 0x4a,
+	0x5a, 0x03, 0xa8, 0x1b, 0x21, 0x9b, 0x7d, 0x9c, 0x5c, 0x01, 0xe4, 0x41, 0x4d, 0x04, 0x44, 0x16,
+	0x0c, 0x86, 0x44, 0x8f, 0xa1, 0x2a, 0x6d, 0x30, 0xa9, 0xf8, 0x3b, 0xe3, 0x4a, 0xc8, 0x59, 0xad,
+	0x89, 0x16, 0xf5, 0xbf, 0x82, 0x25, 0x13, 0xc6, 0x97, 0x61, 0x32, 0xc2, 0x53, 0xf7, 0x5c, 0x83,
+	0x8a, 0x9c, 0xd4, 0x3b, 0x2a, 0x22, 0x77, 0x5d, 0xbb, 0xf9, 0xeb, 0xda, 0x3f, 0x80, 0xe5, 0xe2,
+	0xc9, 0x98, 0x17, 0xd0, 0x6e, 0x76, 0x2f, 0xfe, 0xca, 0x81, 0xa6, 0x01, 0x9a, 0x73, 0xf0, 0x0e,
+	0xe6, 0xeb, 0x99, 0x63, 0x9b, 0xc0, 0x0d, 0x89, 0x0e, 0xa0, 0xa6, 0x14, 0x61, 0xe3, 0x39, 0x6e,
+	0xea, 0x09, 0x36, 0xc2, 0xfe, 0x1f, 0x9c, 0xbc, 0x25, 0x83, 0x2e, 0xa6, 0x53, 0x2d, 0x99, 0x56,
+	0xa6, 0x5a, 0x8f, 0xb9, 0x79, 0x8f, 0x69, 0x9b, 0xcb, 0x93, 0x87, 0xb8, 0x92, 0xbb, 0x85, 0x72,
+	0xea, 0x56, 0xdf, 0x45, 0xdd, 0x57, 0xb0, 0x62, 0x04, 0x4c, 0x15, 0x89, 0xa0, 0x2c, 0x8f, 0x90,
+	0x56, 0x57, 0x8c, 0xd1, 0xc7, 0x50, 0x53, 0xc6, 0xb0, 0xf1, 0xeb, 0xa7, 0x68, 0x6b, 0x60, 0xc4,
+	0xfc, 0xff, 0x3a, 0x50, 0x37, 0x73, 0xf6, 0x38, 0x38, 0xb9, 0xa2, 0x62, 0x32, 0x39, 0xad, 0x43,
+	0xf5, 0x18, 0xf3, 0x30, 0x4e, 0x4c, 0x6c, 0x28, 0x0a, 0xed, 0x65, 0x45, 0x62, 0x59, 0xde, 0x16,
+	0xef, 0x8f, 0x6f, 0x3e, 0x5e, 0x23, 0xa2, 0x6d, 0xed, 0x5e, 0x75, 0xbb, 0xac, 0x8d, 0xcb, 0x8b,
+	0x39, 0xed, 0xf4, 0x87, 0xc6, 0xe9, 0xd5, 0x2d, 0x27, 0x1f, 0xff, 0x85, 0x00, 0xcf, 0x45, 0xef,
+	0xd4, 0x8c, 0xf0, 0x5b, 0x07, 0x1a, 0xaf, 0xae, 0xe3, 0xe8, 0xfa, 0x84, 0xaa, 0x7a, 0xe5, 0x84,
+	0xd2, 0x5c, 0x49, 0xaa, 0x28, 0x11, 0x6c, 0x67, 0x09, 0xe9, 0x86, 0x89, 0xc9, 0x68, 0x86, 0x14,
+	0x8f, 0xaa, 0x23, 0x92, 0x32, 0x1e, 0xa6, 0xdc, 0xbc, 0x51, 0x32, 0x06, 0xda, 0x83, 0x8a, 0x50,
+	0xd5, 0x04, 0xa2, 0x55, 0xd1, 0xee, 0x98, 0xbb, 0x6b, 0x95, 0x64, 0x4e, 0xd1, 0x4a, 0x41, 0xd1,
+	0xa7, 0xb0, 0x54, 0x40, 0x4d, 0x3d, 0x2e, 0x1b, 0x22, 0x9b, 0x30, 0x59, 0x5e, 0xeb, 0xcf, 0x63,
+	0x69, 0x3f, 0x84, 0xca, 0x57, 0x23, 0x4c, 0xef, 0x04, 0x50, 0xe4, 0x63, 0x03, 0x14, 0x63, 0xf4,
+	0x51, 0xf6, 0x30, 0xd7, 0xe5, 0xbc, 0xcd, 0x69, 0x86, 0x1f, 0x58, 0x09, 0xa1, 0xe3, 0x29, 0xa1,
+	0x83, 0x90, 0x9b, 0xcf, 0xad, 0x28, 0xff, 0x09, 0x54, 0x5b, 0x23, 0x3e, 0x1c, 0x71, 0xfb, 0xee,
+	0x70, 0xe4, 0x03, 0x58, 0x8e, 0x67, 0xa6, 0xc0, 0x3f, 0x3a, 0xd0, 0x7c, 0x16, 0xf2, 0xe8, 0x3a,
+	0xc0, 0xdf, 0x8c, 0x30, 0xe3, 0xe8, 0x11, 0x54, 0xce, 0x39, 0x1e, 0x98, 0xec, 0xfa, 0xc0, 0x68,
+	0x22, 0x85, 0xc4, 0x8c, 0xf1, 0x98, 0x94, 0x42, 0x1f, 0xd8, 0x47, 0xb5, 0x56, 0x7d, 0xf2, 0xd1,
+	0xdf, 0xca, 0x1e, 0xfd, 0x2d, 0xf3, 0xe8, 0x77, 0xe7, 0x3e, 0xfa, 0x8d, 0x58, 0xce, 0xd6, 0x72,
+	0xc1, 0xd6, 0x3d, 0x68, 0x58, 0x7d, 0xa6, 0xba, 0x74, 0xf2, 0x25, 0xf0, 0x7b, 0x07, 0x16, 0xb5,
+	0xa1, 0x6c, 0x94, 0x70, 0x91, 0x35, 0xce, 0xd3, 0x1e, 0xbe, 0x95, 0x30, 0x37, 0x50, 0x44, 0xe1,
+	0xc9, 0x66, 0x5c, 0x87, 0xa0, 0x7c, 0x24, 0xd6, 0x57, 0x77, 0xb4, 0x1c, 0x0b, 0xf4, 0x09, 0xa5,
+	0x84, 0x6a, 0xbd, 0x14, 0x21, 0x2a, 0x74, 0x75, 0xf6, 0xd8, 0xf8, 0x09, 0x92, 0xf3, 0x7a, 0x2e,
+	0x30, 0x42, 0xb9, 0x8f, 0x52, 0x2d, 0x7c, 0x94, 0x3f, 0x2d, 0x40, 0xf3, 0x38, 0xc4, 0x03, 0x92,
+	0x76, 0x78, 0xc8, 0x47, 0xf2, 0x08, 0x5c, 0x62, 0xca, 0xb2, 0xca, 0xc2, 0x90, 0xe2, 0x08, 0x9c,
+	0x11, 0x33, 0xa7, 0xcc, 0xcd, 0x18, 0xd2, 0x0d, 0x71, 0xcf, 0xb4, 0x6d, 0xda, 0xb1, 0xac, 0x8c,
+	0xbe, 0x1e, 0xf2, 0x78, 0xa0, 0x72, 0x82, 0x1b, 0x68, 0x4a, 0xac, 0xf3, 0x1c, 0x87, 0x43, 0xf9,
+	0xc2, 0x97, 0xca, 0x97, 0x83, 0x8c, 0x21, 0xf6, 0x17, 0x44, 0x47, 0xb6, 0x6b, 0xc4, 0x9c, 0x21,
+	0xd1, 0x8f, 0x01, 0x5e, 0x11, 0x7a, 0xc3, 0x86, 0x61, 0x84, 0x99, 0xae, 0x04, 0x6d, 0x9e, 0xb1,
+	0x33, 0xca, 0x0c, 0xfd, 0x79, 0x73, 0x00, 0xb4, 0x03, 0x15, 0xf1, 0x5c, 0x61, 0x5e, 0xbd, 0x58,
+	0xdf, 0xe8, 0xf7, 0x51, 0x06, 0x52, 0x62, 0xea, 0xc4, 0x87, 0x09, 0x66, 0x91, 0xae, 0xed, 0xdc,
+	0x20, 0x63, 0xf8, 0x7f, 0x2e, 0xc3, 0xca, 0xd8, 0x9e, 0xb9, 0xa6, 0x8e, 0x33, 0xa3, 0xa9, 0xb3,
+	0x30, 0xb5, 0xa9, 0xe3, 0x4e, 0x6d, 0xea, 0x94, 0x67, 0x37, 0x75, 0x2a, 0xf3, 0x9b, 0x3a, 0xd5,
+	0x89, 0xa6, 0xce, 0xa7, 0x50, 0x6f, 0x53, 0xd2, 0xa7, 0xe1, 0xc0, 0x38, 0x2e, 0xab, 0x22, 0x14,
+	0xbf, 0xe0, 0x01, 0x2b, 0x8c, 0x7e, 0x04, 0x4d, 0x3d, 0x96, 0xf1, 0xe2, 0xd5, 0x8b, 0x39, 0xe3,
+	0x88, 0x8c, 0x52, 0x8e, 0xa9, 0xc1, 0x15, 0x64, 0xc5, 0x97, 0x0c, 0x70, 0x42, 0xc2, 0x1e, 0xd3,
+	0xee, 0x33, 0xa4, 0x9a, 0xe9, 0x8e, 0xe2, 0x84, 0x7b, 0x60, 0x66, 0x24, 0x89, 0x3e, 0x83, 0xa5,
+	0xc3, 0x34, 0x4c, 0xee, 0x58, 0xcc, 0xd4, 0x86, 0x8b, 0x73, 0x37, 0x2c, 0x0a, 0x23, 0x1f, 0x9a,
+	0xf2, 0x6c, 0xe1, 0xde, 0x69, 0x9c, 0x60, 0xe6, 0x35, 0xe5, 0xe2, 0x05, 0x9e, 0x48, 0x9d, 0x92,
+	0x16, 0x95, 0xee, 0x92, 0x74, 0x94, 0xa5, 0xd1, 0x47, 0xe6, 0xa0, 0x2e, 0xcf, 0xdd, 0x55, 0x1f,
+	0xe0, 0x1f, 0xc2, 0xa2, 0x3a, 0xe0, 0x4a, 0xd3, 0x95, 0xb9, 0x98, 0xbc, 0xa8, 0xff, 0x1b, 0x07,
+	0x96, 0x0a, 0x7e, 0xcf, 0x77, 0x09, 0xdd, 0xac, 0x4b, 0x28, 0xd2, 0xbc, 0xba, 0x2e, 0x99, 0x6e,
+	0x91, 0x5a, 0x5a, 0xf8, 0xf0, 0x05, 0x09, 0x7b, 0xc2, 0x0c, 0xd5, 0x2a, 0x31, 0xa4, 0x38, 0x89,
+	0x9d, 0xce, 0xa1, 0x7e, 0x89, 0x8b, 0x21, 0xda, 0x86, 0x15, 0x59, 0x2c, 0x62, 0x6a, 0xfc, 0xa5,
+	0x2f, 0x9d, 0x71, 0xb6, 0x7f, 0x00, 0x75, 0xa3, 0xb8, 0x08, 0xcf, 0xe7, 0x31, 0x67, 0x3a, 0x6b,
+	0xc9, 0xb1, 0x08, 0xcf, 0x97, 0x31, 0x63, 0x56, 0x1f, 0x4d, 0xf9, 0x3d, 0x80, 0xec, 0x1c, 0x49,
+	0x29, 0xf5, 0xb4, 0xd2, 0x07, 0x21, 0x2b, 0x18, 0x65, 0xfa, 0x5c, 0x98, 0x4c, 0x9f, 0x59, 0xc1,
+	0x28, 0x2c, 0x3b, 0x49, 0xc2, 0x21, 0xd3, 0xdd, 0x04, 0x37, 0x30, 0xa4, 0x1f, 0x40, 0x33, 0x9f,
+	0xdd, 0x0a, 0xfe, 0x51, 0x8e, 0xcb, 0xfc, 0x33, 0x59, 0xbc, 0xd8, 0xc7, 0x8a, 0x9b, 0x7b, 0xac,
+	0xf8, 0x0d, 0xa8, 0xe9, 0xfb, 0xc8, 0x07, 0xd1, 0x56, 0x64, 0x43, 0x92, 0x32, 0xbc, 0xff, 0x97,
+	0x1a, 0xb8, 0x67, 0xa4, 0x87, 0x1e, 0x42, 0xb9, 0x2d, 0x9c, 0xba, 0x92, 0x75, 0xbd, 0xa4, 0xf0,
+	0xc6, 0x6a, 0xc6, 0x50, 0x10, 0xbf, 0x84, 0x76, 0xa1, 0xde, 0xb9, 0x1e, 0xf1, 0x1e, 0xf9, 0x45,
+	0x7a, 0x3f, 0xc0, 0x1e, 0x54, 0xb5, 0xcb, 0x26, 0xc4, 0xb3, 0x8a, 0x28, 0x97, 0x9d, 0x25, 0x04,
+	0xce, 0x30, 0xb7, 0xcd, 0xb0, 0xf1, 0xdb, 0x7b, 0x63, 0xa5, 0xd8, 0xe7, 0x1a, 0x83, 0xd0, 0x6f,
+	0x87, 0x50, 0x01, 0xf9, 0x04, 0x9a, 0x1a, 0xa2, 0x5b, 0x47, 0x13, 0xa0, 0x07, 0x63, 0xf9, 0x33,
+	0xba, 0xf1, 0x4b, 0xe8, 0x53, 0x58, 0x3a, 0xc3, 0x3c, 0xd7, 0xfb, 0x9b, 0xc4, 0xd9, 0xbc, 0x9b,
+	0x49, 0xf9, 0x25, 0xf4, 0x18, 0x16, 0x25, 0x50, 0x57, 0xa3, 0x93, 0xb0, 0xd5, 0xf1, 0xaa, 0xcf,
+	0x82, 0x6c, 0x83, 0x69, 0x0e, 0xc8, 0xc8, 0x58, 0x15, 0x73, 0x8d, 0x9e, 0x39, 0x2a, 0x66, 0x52,
+	0x7e, 0x09, 0x3d, 0x82, 0xfa, 0x19, 0xe6, 0xba, 0xa9, 0x3d, 0x81, 0x59, 0x32, 0x1c, 0x29, 0xe0,
+	0x97, 0xd0, 0x13, 0xa9, 0x9c, 0x7d, 0xf3, 0xcd, 0x71, 0xa0, 0x91, 0x11, 0xa8, 0xcf, 0xa4, 0xdf,
+	0x6d, 0x67, 0x75, 0x0a, 0x6c, 0x66, 0xfb, 0xd5, 0x2f, 0xa1, 0xa7, 0xb0, 0xd2, 0xe1, 0x14, 0x87,
+	0x83, 0x79, 0x0b, 0xac, 0x4f, 0x2c, 0x20, 0x9b, 0xc3, 0x7e, 0xe9, 0x63, 0x07, 0x3d, 0x84, 0xda,
+	0x19, 0xe6, 0xb2, 0x25, 0x30, 0x09, 0x6c, 0x66, 0x55, 0x6d, 0xc8, 0x6d, 0x8c, 0x64, 0x45, 0xf5,
+	0x1c, 0x13, 0xad, 0x90, 0x5f, 0x42, 0x1f, 0x42, 0xa5, 0x4d, 0xe3, 0x94, 0x23, 0xeb, 0x32, 0x59,
+	0xae, 0x6e, 0xd8, 0x16, 0xa3, 0x2a, 0x2d, 0xa5, 0x3e, 0x07, 0x50, 0x91, 0x85, 0x14, 0x5a, 0x2b,
+	0xd4, 0x86, 0xe6, 0x8c, 0xbc, 0x37, 0xc6, 0x15, 0x19, 0x56, 0xe0, 0x9e, 0xad, 0xbd, 0xf9, 0xf7,
+	0x66, 0xe9, 0xcd, 0xdb, 0x4d, 0xe7, 0xef, 0x6f, 0x37, 0x9d, 0x7f, 0xbd, 0xdd, 0x74, 0x7e, 0xfd,
+	0x9f, 0xcd, 0x52, 0xb7, 0x2a, 0xff, 0x9d, 0x7a, 0xfc, 0xff, 0x01, 0x00, 0x3e, 0x01, 0xfc, 0x03,
+	0xeb, 0x1a, 0x00, 0x00,
 }
@@ -5,46 +5,111 @@
 package god
 
 import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
 	"go/build"
 	"go/token"
+	"io"
 	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/zchee/god/internal/buildkey"
 	"github.com/zchee/god/internal/guru"
+	"github.com/zchee/god/internal/index"
 	"github.com/zchee/god/internal/log"
+	"github.com/zchee/god/internal/singleflight"
 	serialpb "github.com/zchee/god/serial"
 
 	"golang.org/x/net/context"
+	"golang.org/x/net/trace"
 	"golang.org/x/tools/cmd/guru/serial"
+	"golang.org/x/tools/go/buildutil"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
-// Address is the god gRPC server address.
-const Address = ":7154" // g: 7, o: 15, d: 4
-
 // Server represents a god server.
+//
+// A Server answers queries concurrently: each query collects its own
+// results, and only the caches shared by all queries are locked.
 type Server struct {
-	grpcs  *grpc.Server
-	mu     sync.RWMutex
-	done   chan struct{}
-	result interface{}
+	// Addr is the address to listen on, as accepted by Listen.
+	// If empty, DefaultAddress is used.
+	Addr string
+
+	// IdleTimeout is how long the server waits without any query
+	// before it stops by itself.  If zero, it runs until stopped.
+	IdleTimeout time.Duration
+
+	// DebugAddr, if set, is the TCP address of an HTTP server that
+	// serves the traces of requests, unless grpc.EnableTracing is
+	// false, the events of the server, and profiles of the daemon; see
+	// debugHandler.  It should be a loopback address.
+	DebugAddr string
+
+	grpcs    *grpc.Server
+	events   trace.EventLog
+	flight   singleflight.Group // of identical queries in progress
+	debug    net.Addr           // of the debug HTTP server, once listening
+	mu       sync.RWMutex
+	calls    map[*call]bool // requests in progress
+	started  time.Time
+	last     time.Time // end of the last request
+	done     chan struct{}
+	stopOnce sync.Once
+
+	ctxt             *build.Context        // of the queries that request none
+	indexDir         string                // where workspaces save their indexes
+	defaultWorkspace *workspace            // of ctxt
+	workspaces       map[string]*workspace // keyed by buildkey.Key
 }
 
+// indexGrace is how long a query that the index can answer waits for
+// guru before it is answered from the index, if a program of its
+// workspace is still loading.
+const indexGrace = 50 * time.Millisecond
+
 // NewServer returns the new Server.
 func NewServer() *Server {
-	s := grpc.NewServer()
+	return newServer(&build.Default, index.Dir())
+}
+
+// newServer returns a Server whose queries use ctxt unless they request
+// another build context, and whose workspaces save their indexes in
+// indexDir.
+func newServer(ctxt *build.Context, indexDir string) *Server {
 	srv := &Server{
-		grpcs: s,
+		calls:            make(map[*call]bool),
+		done:             make(chan struct{}),
+		ctxt:             ctxt,
+		indexDir:         indexDir,
+		defaultWorkspace: newWorkspace(ctxt, indexDir),
+		workspaces:       make(map[string]*workspace),
+		events:           trace.NewEventLog("god.Server", "daemon"),
 	}
-	serialpb.RegisterGodServer(s, srv)
+	srv.workspaces[buildkey.Key(ctxt)] = srv.defaultWorkspace
+	srv.grpcs = grpc.NewServer(
+		grpc.UnaryInterceptor(srv.unaryInterceptor),
+		grpc.StreamInterceptor(srv.streamInterceptor),
+	)
+	serialpb.RegisterGodServer(srv.grpcs, srv)
 	return srv
 }
 
 // serve serve the god gRPC server.
 func (s *Server) serve() error {
 	log.Debug("serve")
-	lis, err := net.Listen("tcp", Address)
+	lis, err := Listen(s.Addr)
 	if err != nil {
 		return err
 	}
@@ -52,9 +117,40 @@ func (s *Server) serve() error {
 	return s.grpcs.Serve(lis)
 }
 
-// Start starts the god gRPC server.
+// Start starts the god gRPC server, and returns once it is stopped.
+// Start returns ErrRunning if another daemon serves the same address.
 func (s *Server) Start() error {
 	log.Debug("Start")
+	lock, err := lockPID(s.Addr)
+	if err != nil {
+		return err
+	}
+	defer lock.release()
+
+	defer s.events.Finish()
+	network, address := ResolveAddress(s.Addr)
+	s.events.Printf("start: %s %s, pid %d", network, address, os.Getpid())
+	if s.DebugAddr != "" {
+		srv, addr, err := serveDebug(s.DebugAddr)
+		if err != nil {
+			return err
+		}
+		defer srv.Close()
+		s.mu.Lock()
+		s.debug = addr
+		s.mu.Unlock()
+		s.events.Printf("debug HTTP server on %s", addr)
+	}
+
+	go s.defaultWorkspace.warmIndex()
+	s.mu.Lock()
+	s.started = time.Now()
+	s.last = s.started
+	s.mu.Unlock()
+	if s.IdleTimeout > 0 {
+		go s.stopWhenIdle()
+	}
+
 	errc := make(chan error, 1)
 	go func() {
 		errc <- s.serve()
@@ -64,34 +160,321 @@ func (s *Server) Start() error {
 	select {
 	case err := <-errc:
 		if err != nil {
+			s.events.Errorf("serve: %v", err)
+			s.closeWorkspaces()
 			return err
 		}
 	case <-s.done:
-		s.grpcs.Stop()
+		s.events.Printf("stop")
+		s.grpcs.GracefulStop()
+		s.closeWorkspaces()
 	}
 
 	return nil
 }
 
-// Stop sends empty struct to done chan, and stops the god gRPC server.
+// Stop stops the god gRPC server once the requests in progress are done.
+// Stop does not wait for them.
 func (s *Server) Stop() {
-	log.Debug("Done")
+	log.Debug("Stop")
+	s.stopOnce.Do(func() { close(s.done) })
+}
+
+// stopWhenIdle stops the server once no request has been in progress
+// for IdleTimeout.
+func (s *Server) stopWhenIdle() {
+	timer := time.NewTimer(s.IdleTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-timer.C:
+		}
+		s.mu.RLock()
+		idle := time.Since(s.last)
+		active := len(s.calls)
+		s.mu.RUnlock()
+		if active == 0 && idle >= s.IdleTimeout {
+			log.Debugf("idle for %v", idle)
+			s.events.Printf("idle for %v", idle)
+			s.Stop()
+			return
+		}
+		d := s.IdleTimeout - idle
+		if active > 0 {
+			d = s.IdleTimeout
+		}
+		timer.Reset(d)
+	}
+}
+
+// A call is a request in progress.
+type call struct {
+	method string // e.g. "GetDefinition"
+	start  time.Time
+
+	mode, pos string // of the query, once received; guarded by Server.mu
+}
+
+// begin and end record the start and end of a request.
+func (s *Server) begin(fullMethod string) *call {
+	c := &call{
+		method: fullMethod[strings.LastIndex(fullMethod, "/")+1:],
+		start:  time.Now(),
+	}
 	s.mu.Lock()
-	s.done <- struct{}{}
+	s.calls[c] = true
 	s.mu.Unlock()
+	return c
 }
 
-func (s *Server) Output(fset *token.FileSet, qr guru.QueryResult) {
+func (s *Server) end(c *call) {
 	s.mu.Lock()
-	s.result = qr.Result(fset)
+	delete(s.calls, c)
+	s.last = time.Now()
 	s.mu.Unlock()
 }
 
-func (s *Server) query(loc *serialpb.Location) *guru.Query {
+// received records the query of c, whose request is req.
+func (s *Server) received(c *call, req interface{}) {
+	var (
+		mode string
+		loc  *serialpb.Location
+	)
+	switch req := req.(type) {
+	case *serialpb.Location:
+		loc = req
+	case *serialpb.Query:
+		mode, loc = req.Mode, req.Location
+	case *serialpb.BatchRequest:
+		mode = "batch"
+		if len(req.Items) > 0 {
+			loc = &serialpb.Location{Pos: req.Items[0].Pos}
+		}
+	}
+	if loc == nil {
+		return
+	}
+	pos := loc.Pos
+	if loc.Filename != "" {
+		pos = fmt.Sprintf("%s:%d:%d", loc.Filename, loc.Line, loc.Col)
+	}
+	s.mu.Lock()
+	c.mode, c.pos = mode, pos
+	s.mu.Unlock()
+}
+
+// queryMethods are the unary methods that run a query, whose identical
+// calls are coalesced and whose responses are cached.
+var queryMethods = map[string]bool{
+	"/serial.God/GetCallees":    true,
+	"/serial.God/GetCallers":    true,
+	"/serial.God/GetCallStack":  true,
+	"/serial.God/GetDefinition": true,
+	"/serial.God/GetDescribe":   true,
+	"/serial.God/GetFreeVars":   true,
+	"/serial.God/GetImplements": true,
+	"/serial.God/GetPeers":      true,
+	"/serial.God/GetPointsTo":   true,
+	"/serial.God/GetReferrers":  true,
+	"/serial.God/GetWhat":       true,
+	"/serial.God/GetWhichErrs":  true,
+}
+
+func (s *Server) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	c := s.begin(info.FullMethod)
+	defer s.end(c)
+	s.received(c, req)
+	err = protect(func() (err error) {
+		if loc, ok := req.(*serialpb.Location); ok && queryMethods[info.FullMethod] {
+			if key, ok := flightKey(info.FullMethod, req); ok {
+				results := s.workspace(loc.Options).results
+				if msgs, ok := results.get(key); ok {
+					resp = msgs[0]
+					return nil
+				}
+				resp, err = s.coalesce(ctx, key, func() (interface{}, error) {
+					versions := new(guru.Versions)
+					resp, err := handler(withVersions(ctx, versions), req)
+					if m, ok := resp.(message); ok && err == nil {
+						results.put(key, reflect.TypeOf(m), []message{m}, versions)
+					}
+					return resp, err
+				})
+				return err
+			}
+		}
+		resp, err = handler(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+// flightKey returns the key of the coalesced calls of the request req of
+// method: the method and a hash of the request, which holds the mode,
+// position, options and overlays of its query.
+func flightKey(method string, req interface{}) (string, bool) {
+	m, ok := req.(interface {
+		Marshal() ([]byte, error)
+	})
+	if !ok {
+		return "", false
+	}
+	b, err := m.Marshal()
+	if err != nil {
+		return "", false
+	}
+	sum := sha1.Sum(b)
+	return method + " " + hex.EncodeToString(sum[:]), true
+}
+
+// coalesce calls fn, which answers a query with ctx, unless an identical
+// query is in progress; coalesce then returns its result instead.  The
+// result is shared, so it must not be modified.
+func (s *Server) coalesce(ctx context.Context, key string, fn func() (interface{}, error)) (interface{}, error) {
+	for {
+		v, err, coalesced := s.flight.Do(key, fn)
+		if !coalesced {
+			return v, err
+		}
+		switch {
+		case err == singleflight.ErrPanicked:
+			return nil, grpc.Errorf(codes.Internal, "internal error: %v", err)
+		case ctx.Err() == nil && (grpc.Code(err) == codes.Canceled || grpc.Code(err) == codes.DeadlineExceeded):
+			continue // cancelled by the client of the other query
+		}
+		return v, err
+	}
+}
+
+func (s *Server) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	c := s.begin(info.FullMethod)
+	defer s.end(c)
+	return protect(func() error {
+		return handler(srv, &recvStream{ServerStream: ss, s: s, c: c})
+	})
+}
+
+// protect calls f and returns its error, or a codes.Internal error if f
+// panics, or if a query of f panicked on a goroutine of its own, which
+// guru recovers and returns as a *guru.PanicError, so that a bad query
+// cannot bring down the daemon.  The panic is logged with the stack of
+// the goroutine that panicked.
+func protect(f func() error) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			log.Printf("panic: %v\n%s", v, debug.Stack())
+			err = grpc.Errorf(codes.Internal, "internal error: %v", v)
+		}
+	}()
+	err = f()
+	if perr, ok := err.(*guru.PanicError); ok {
+		log.Printf("panic: %v\n%s", perr.Value, perr.Stack)
+		err = grpc.Errorf(codes.Internal, "internal error: %v", perr.Value)
+	}
+	return err
+}
+
+// resultError returns the error of a query whose result is not of the
+// type its mode returns.
+func resultError(result interface{}) error {
+	return grpc.Errorf(codes.Internal, "internal error: unexpected result %T", result)
+}
+
+// A recvStream records the request of a streaming call.
+type recvStream struct {
+	grpc.ServerStream
+	s *Server
+	c *call
+}
+
+func (ss *recvStream) RecvMsg(m interface{}) error {
+	if err := ss.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	ss.s.received(ss.c, m)
+	return nil
+}
+
+// run runs the query of the specified mode at loc, which must output a
+// single result, and returns that result.
+func (s *Server) run(ctx context.Context, loc *serialpb.Location, mode string) (interface{}, error) {
+	query := s.query(ctx, loc)
+	var result interface{}
+	query.Output = func(fset *token.FileSet, qr guru.QueryResult) {
+		result = qr.Result(fset)
+	}
+	if err := guru.Run(mode, query); err != nil {
+		return nil, queryError(query, mode, err)
+	}
+	if result == nil {
+		return nil, grpc.Errorf(codes.NotFound, "query returned no result")
+	}
+	return result, nil
+}
+
+// wait waits for the guru query at loc, whose error is sent on errc.
+// If a program of the workspace of loc is still loading after
+// indexGrace, wait first calls answer to answer the query from the
+// index, at the file name and offset of the query, and returns
+// fromIndex if it could.
+func (s *Server) wait(loc *serialpb.Location, query *guru.Query, errc <-chan error, answer func(x *index.Index, filename string, offset int) bool) (fromIndex bool, err error) {
+	select {
+	case err := <-errc:
+		return false, err
+	case <-time.After(indexGrace):
+	}
+	if s.workspace(loc.Options).cache.Loading() {
+		if x, filename, offset, ok := s.indexPos(loc, query); ok && answer(x, filename, offset) {
+			query.Versions.MarkIncomplete()
+			return true, nil
+		}
+	}
+	return false, <-errc
+}
+
+// indexPos returns the index of the workspace of the query at loc, and
+// the file name and offset of the query for it, unless loc has overlays,
+// which the index does not know about.
+func (s *Server) indexPos(loc *serialpb.Location, query *guru.Query) (x *index.Index, filename string, offset int, ok bool) {
+	x = s.workspace(loc.Options).index
+	if x == nil || len(loc.Overlays) > 0 {
+		return nil, "", 0, false
+	}
+	filename, offset, _, err := guru.ParsePos(query.Build, query.Pos)
+	if err != nil {
+		return nil, "", 0, false
+	}
+	if filename, err = filepath.Abs(filename); err != nil {
+		return nil, "", 0, false
+	}
+	return x, filename, offset, true
+}
+
+// query returns the guru query at loc, which is cancelled with ctx and
+// records the versions of the files it reads in those of ctx, if any.
+// The filename, line and col fields of loc, if set, take precedence
+// over its pos.
+func (s *Server) query(ctx context.Context, loc *serialpb.Location) *guru.Query {
+	pos := loc.Pos
+	if loc.Filename != "" {
+		pos = fmt.Sprintf("%s:%d:%d", loc.Filename, loc.Line, loc.Col)
+	}
+	w := s.workspace(loc.Options)
 	q := &guru.Query{
-		Pos:    loc.Pos,
-		Build:  &build.Default,
-		Output: s.Output,
+		Pos:      pos,
+		Build:    w.ctxt,
+		Cache:    w.cache,
+		Versions: versionsFrom(ctx),
+		Context:  ctx,
+	}
+	if len(loc.Overlays) > 0 {
+		overlay := make(map[string][]byte, len(loc.Overlays))
+		for _, o := range loc.Overlays {
+			overlay[o.Filename] = o.Content
+		}
+		q.Build = buildutil.OverlayContext(q.Build, overlay)
 	}
 	if loc.Options != nil {
 		// avoid corner case of split("")
@@ -99,6 +482,7 @@ func (s *Server) query(loc *serialpb.Location) *guru.Query {
 			scopes := strings.Split(loc.Options.Scope, ",")
 			q.Scope = scopes
 		}
+		q.Reflection = loc.Options.Reflection
 	}
 	return q
 }
@@ -107,16 +491,73 @@ func (s *Server) Ping(ctx context.Context, req *serialpb.Request) (*serialpb.Res
 	return &serialpb.Response{}, nil
 }
 
-func (s *Server) GetCallees(ctx context.Context, loc *serialpb.Location) (*serialpb.Callees, error) {
-	query := s.query(loc)
-	if err := guru.Callees(query); err != nil {
-		return nil, err
+// Shutdown stops the server once the other requests in progress are done.
+func (s *Server) Shutdown(ctx context.Context, req *serialpb.Request) (*serialpb.Response, error) {
+	s.Stop()
+	return &serialpb.Response{}, nil
+}
+
+// Status describes what the server holds and is doing.
+func (s *Server) Status(ctx context.Context, req *serialpb.Request) (*serialpb.DaemonStatus, error) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	st := &serialpb.DaemonStatus{
+		Version:   Version,
+		GoVersion: runtime.Version(),
+		Pid:       int64(os.Getpid()),
+		HeapAlloc: mem.HeapAlloc,
+		HeapSys:   mem.HeapSys,
+		Coalesced: s.flight.Coalesced(),
 	}
 
 	s.mu.RLock()
-	res := s.result.(*serial.Callees)
+	now := time.Now()
+	st.Uptime = int64(now.Sub(s.started))
+	for c := range s.calls {
+		if c.method == "Status" {
+			continue
+		}
+		st.Calls = append(st.Calls, serialpb.CallStatus{
+			Method:  c.method,
+			Mode:    c.mode,
+			Pos:     c.pos,
+			Elapsed: int64(now.Sub(c.start)),
+		})
+	}
+	keys := make([]string, 0, len(s.workspaces))
+	for key := range s.workspaces {
+		keys = append(keys, key)
+	}
+	workspaces := make([]*workspace, len(keys))
+	sort.Strings(keys)
+	for i, key := range keys {
+		workspaces[i] = s.workspaces[key]
+	}
 	s.mu.RUnlock()
 
+	sort.Sort(byElapsed(st.Calls))
+	for _, w := range workspaces {
+		st.Workspaces = append(st.Workspaces, w.status())
+	}
+	return st, nil
+}
+
+type byElapsed []serialpb.CallStatus
+
+func (s byElapsed) Len() int           { return len(s) }
+func (s byElapsed) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byElapsed) Less(i, j int) bool { return s[i].Elapsed > s[j].Elapsed }
+
+func (s *Server) GetCallees(ctx context.Context, loc *serialpb.Location) (*serialpb.Callees, error) {
+	result, err := s.run(ctx, loc, "callees")
+	if err != nil {
+		return nil, err
+	}
+	res, ok := result.(*serial.Callees)
+	if !ok {
+		return nil, resultError(result)
+	}
+
 	callees := make([]*serialpb.Callee, len(res.Callees))
 	for i, callee := range res.Callees {
 		callees[i] = &serialpb.Callee{
@@ -133,14 +574,14 @@ func (s *Server) GetCallees(ctx context.Context, loc *serialpb.Location) (*seria
 }
 
 func (s *Server) GetCallers(ctx context.Context, loc *serialpb.Location) (*serialpb.Callers, error) {
-	query := s.query(loc)
-	if err := guru.Callers(query); err != nil {
+	result, err := s.run(ctx, loc, "callers")
+	if err != nil {
 		return nil, err
 	}
-
-	s.mu.RLock()
-	res := s.result.([]serial.Caller)
-	s.mu.RUnlock()
+	res, ok := result.([]serial.Caller)
+	if !ok {
+		return nil, resultError(result)
+	}
 
 	callers := &serialpb.Callers{
 		Callers: make([]*serialpb.Caller, len(res)),
@@ -157,14 +598,14 @@ func (s *Server) GetCallers(ctx context.Context, loc *serialpb.Location) (*seria
 }
 
 func (s *Server) GetCallStack(ctx context.Context, loc *serialpb.Location) (*serialpb.CallStack, error) {
-	query := s.query(loc)
-	if err := guru.Callstack(query); err != nil {
+	result, err := s.run(ctx, loc, "callstack")
+	if err != nil {
 		return nil, err
 	}
-
-	s.mu.RLock()
-	res := s.result.(*serial.CallStack)
-	s.mu.RUnlock()
+	res, ok := result.(*serial.CallStack)
+	if !ok {
+		return nil, resultError(result)
+	}
 
 	callers := make([]serialpb.Caller, len(res.Callers))
 	for i, caller := range res.Callers {
@@ -183,14 +624,32 @@ func (s *Server) GetCallStack(ctx context.Context, loc *serialpb.Location) (*ser
 }
 
 func (s *Server) GetDefinition(ctx context.Context, loc *serialpb.Location) (*serialpb.Definition, error) {
-	query := s.query(loc)
-	if err := guru.Definition(query); err != nil {
-		return nil, err
+	query := s.query(ctx, loc)
+	var result interface{}
+	query.Output = func(fset *token.FileSet, qr guru.QueryResult) {
+		result = qr.Result(fset)
 	}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- protect(func() error { return guru.Definition(query) })
+	}()
 
-	s.mu.RLock()
-	def := s.result.(*serial.Definition)
-	s.mu.RUnlock()
+	var indexed *serialpb.Definition
+	fromIndex, err := s.wait(loc, query, errc, func(x *index.Index, filename string, offset int) bool {
+		objPos, desc, ok := x.Definition(filename, offset)
+		indexed = &serialpb.Definition{ObjPos: objPos, Desc: desc}
+		return ok
+	})
+	if fromIndex {
+		return indexed, nil
+	}
+	if err != nil {
+		return nil, queryError(query, "definition", err)
+	}
+	def, ok := result.(*serial.Definition)
+	if !ok {
+		return nil, resultError(result)
+	}
 
 	return &serialpb.Definition{
 		ObjPos: def.ObjPos,
@@ -199,14 +658,14 @@ func (s *Server) GetDefinition(ctx context.Context, loc *serialpb.Location) (*se
 }
 
 func (s *Server) GetDescribe(ctx context.Context, loc *serialpb.Location) (*serialpb.Describe, error) {
-	query := s.query(loc)
-	if err := guru.Describe(query); err != nil {
+	result, err := s.run(ctx, loc, "describe")
+	if err != nil {
 		return nil, err
 	}
-
-	s.mu.RLock()
-	res := s.result.(*serial.Describe)
-	s.mu.RUnlock()
+	res, ok := result.(*serial.Describe)
+	if !ok {
+		return nil, resultError(result)
+	}
 
 	desc := &serialpb.Describe{
 		Desc:   res.Desc,
@@ -259,14 +718,14 @@ func (s *Server) GetDescribe(ctx context.Context, loc *serialpb.Location) (*seri
 }
 
 func (s *Server) GetFreeVars(ctx context.Context, loc *serialpb.Location) (*serialpb.FreeVars, error) {
-	query := s.query(loc)
-	if err := guru.Freevars(query); err != nil {
+	result, err := s.run(ctx, loc, "freevars")
+	if err != nil {
 		return nil, err
 	}
-
-	s.mu.RLock()
-	res := s.result.([]serial.FreeVar)
-	s.mu.RUnlock()
+	res, ok := result.([]serial.FreeVar)
+	if !ok {
+		return nil, resultError(result)
+	}
 
 	frs := &serialpb.FreeVars{
 		FreeVar: make([]serialpb.FreeVar, len(res)),
@@ -284,14 +743,14 @@ func (s *Server) GetFreeVars(ctx context.Context, loc *serialpb.Location) (*seri
 }
 
 func (s *Server) GetImplements(ctx context.Context, loc *serialpb.Location) (*serialpb.Implements, error) {
-	query := s.query(loc)
-	if err := guru.Implements(query); err != nil {
+	result, err := s.run(ctx, loc, "implements")
+	if err != nil {
 		return nil, err
 	}
-
-	s.mu.RLock()
-	res := s.result.(*serial.Implements)
-	s.mu.RUnlock()
+	res, ok := result.(*serial.Implements)
+	if !ok {
+		return nil, resultError(result)
+	}
 
 	impl := &serialpb.Implements{
 		T: serialpb.ImplementsType{
@@ -357,14 +816,14 @@ func (s *Server) GetImplements(ctx context.Context, loc *serialpb.Location) (*se
 }
 
 func (s *Server) GetPeers(ctx context.Context, loc *serialpb.Location) (*serialpb.Peers, error) {
-	query := s.query(loc)
-	if err := guru.Implements(query); err != nil {
+	result, err := s.run(ctx, loc, "peers")
+	if err != nil {
 		return nil, err
 	}
-
-	s.mu.RLock()
-	res := s.result.(*serial.Peers)
-	s.mu.RUnlock()
+	res, ok := result.(*serial.Peers)
+	if !ok {
+		return nil, resultError(result)
+	}
 
 	peers := &serialpb.Peers{
 		Pos:      res.Pos,
@@ -379,14 +838,14 @@ func (s *Server) GetPeers(ctx context.Context, loc *serialpb.Location) (*serialp
 }
 
 func (s *Server) GetPointsTo(ctx context.Context, loc *serialpb.Location) (*serialpb.PointsTos, error) {
-	query := s.query(loc)
-	if err := guru.Implements(query); err != nil {
+	result, err := s.run(ctx, loc, "pointsto")
+	if err != nil {
 		return nil, err
 	}
-
-	s.mu.RLock()
-	res := s.result.([]serial.PointsTo)
-	s.mu.RUnlock()
+	res, ok := result.([]serial.PointsTo)
+	if !ok {
+		return nil, resultError(result)
+	}
 
 	pts := &serialpb.PointsTos{
 		PointsTos: make([]serialpb.PointsTo, len(res)),
@@ -407,14 +866,394 @@ func (s *Server) GetPointsTo(ctx context.Context, loc *serialpb.Location) (*seri
 	return pts, nil
 }
 
+// GetReferrers returns all references to the object at loc as a single
+// ReferrersPackage, named after the package that declares the object.
 func (s *Server) GetReferrers(ctx context.Context, loc *serialpb.Location) (*serialpb.ReferrersPackage, error) {
-	return &serialpb.ReferrersPackage{}, nil
+	query := s.query(ctx, loc)
+	var (
+		mu     sync.Mutex // Output is called concurrently for global queries
+		objPos string
+		refs   []serialpb.Ref
+	)
+	query.Output = func(fset *token.FileSet, qr guru.QueryResult) {
+		res := qr.Result(fset)
+		mu.Lock()
+		defer mu.Unlock()
+		switch res := res.(type) {
+		case *serial.ReferrersInitial:
+			objPos = res.ObjPos
+		case serial.ReferrersPackage:
+			for _, ref := range res.Refs {
+				refs = append(refs, serialpb.Ref{
+					Pos:  ref.Pos,
+					Text: ref.Text,
+				})
+			}
+		}
+	}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- protect(func() error { return guru.Referrers(query) })
+	}()
+
+	var indexed *serialpb.ReferrersPackage
+	fromIndex, err := s.wait(loc, query, errc, func(x *index.Index, filename string, offset int) bool {
+		objPos, irefs, ok := x.Referrers(filename, offset)
+		refs := make([]serialpb.Ref, len(irefs))
+		for i, ref := range irefs {
+			refs[i] = serialpb.Ref{
+				Pos:  ref.Pos,
+				Text: ref.Text,
+			}
+		}
+		indexed = s.referrersPackage(query.Build, objPos, refs)
+		return ok
+	})
+	if fromIndex {
+		return indexed, nil
+	}
+	if err != nil {
+		return nil, queryError(query, "referrers", err)
+	}
+
+	return s.referrersPackage(query.Build, objPos, refs), nil
+}
+
+// StreamReferrers streams the references to the object at loc: first
+// the object, then each package containing references, as soon as it
+// is found.
+func (s *Server) StreamReferrers(loc *serialpb.Location, stream serialpb.God_StreamReferrersServer) error {
+	query := s.query(stream.Context(), loc)
+	var (
+		mu      sync.Mutex // Output is called concurrently for global queries
+		sendErr error
+	)
+	query.Output = func(fset *token.FileSet, qr guru.QueryResult) {
+		event := new(serialpb.ReferrersEvent)
+		switch res := qr.Result(fset).(type) {
+		case *serial.ReferrersInitial:
+			event.Initial = &serialpb.ReferrersInitial{
+				ObjPos: res.ObjPos,
+				Desc:   res.Desc,
+			}
+		case serial.ReferrersPackage:
+			pkg := &serialpb.ReferrersPackage{
+				Package: res.Package,
+				Refs:    make([]serialpb.Ref, len(res.Refs)),
+			}
+			for i, ref := range res.Refs {
+				pkg.Refs[i] = serialpb.Ref{
+					Pos:  ref.Pos,
+					Text: ref.Text,
+				}
+			}
+			event.Package = pkg
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if sendErr == nil {
+			sendErr = stream.Send(event)
+		}
+	}
+	if err := guru.Referrers(query); err != nil {
+		return queryError(query, "referrers", err)
+	}
+	return sendErr
+}
+
+// referrersPackage returns a ReferrersPackage holding refs, sorted by
+// position, and named after the package declaring the object at objPos.
+func (s *Server) referrersPackage(ctxt *build.Context, objPos string, refs []serialpb.Ref) *serialpb.ReferrersPackage {
+	sort.Sort(byRefPos(refs))
+	pkg := &serialpb.ReferrersPackage{Refs: refs}
+	if filename, _, _, ok := SplitPosition(objPos); ok {
+		if bp, err := buildutil.ContainingPackage(ctxt, "", filename); err == nil {
+			pkg.Package = bp.ImportPath
+		}
+	}
+	return pkg
+}
+
+type byRefPos []serialpb.Ref
+
+func (s byRefPos) Len() int      { return len(s) }
+func (s byRefPos) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byRefPos) Less(i, j int) bool {
+	fi, li, ci, _ := SplitPosition(s[i].Pos)
+	fj, lj, cj, _ := SplitPosition(s[j].Pos)
+	if fi != fj {
+		return fi < fj
+	}
+	if li != lj {
+		return li < lj
+	}
+	return ci < cj
 }
 
 func (s *Server) GetWhat(ctx context.Context, loc *serialpb.Location) (*serialpb.What, error) {
-	return &serialpb.What{}, nil
+	result, err := s.run(ctx, loc, "what")
+	if err != nil {
+		return nil, err
+	}
+	res, ok := result.(*serial.What)
+	if !ok {
+		return nil, resultError(result)
+	}
+
+	what := &serialpb.What{
+		Enclosing:  make([]serialpb.SyntaxNode, len(res.Enclosing)),
+		Modes:      res.Modes,
+		SrcDir:     res.SrcDir,
+		ImportPath: res.ImportPath,
+		Object:     res.Object,
+		SameIDs:    res.SameIDs,
+	}
+	for i, node := range res.Enclosing {
+		what.Enclosing[i] = serialpb.SyntaxNode{
+			Description: node.Description,
+			Start:       int32(node.Start),
+			End:         int32(node.End),
+		}
+	}
+
+	return what, nil
 }
 
 func (s *Server) GetWhichErrs(ctx context.Context, loc *serialpb.Location) (*serialpb.WhichErrs, error) {
-	return &serialpb.WhichErrs{}, nil
+	result, err := s.run(ctx, loc, "whicherrs")
+	if err != nil {
+		return nil, err
+	}
+	res, ok := result.(*serial.WhichErrs)
+	if !ok {
+		return nil, resultError(result)
+	}
+
+	errs := &serialpb.WhichErrs{
+		ErrPos:    res.ErrPos,
+		Globals:   res.Globals,
+		Constants: res.Constants,
+		Types:     make([]serialpb.WhichErrsType, len(res.Types)),
+	}
+	for i, typ := range res.Types {
+		errs.Types[i] = serialpb.WhichErrsType{
+			Type:     typ.Type,
+			Position: typ.Position,
+		}
+	}
+
+	return errs, nil
+}
+
+// Print runs the query of q.Mode at q.Location and streams its output in
+// q.Format, each result as soon as it is found.
+func (s *Server) Print(q *serialpb.Query, stream serialpb.God_PrintServer) error {
+	if q.Location == nil {
+		return grpc.Errorf(codes.InvalidArgument, "no query location")
+	}
+	write, err := printer(q.Format)
+	if err != nil {
+		return err
+	}
+
+	// The output is streamed as it is found, and recorded for the
+	// identical queries that are coalesced with this one and for the
+	// result cache.
+	ctx := stream.Context()
+	key, cache := flightKey("Print", q)
+	results := s.workspace(q.Location.Options).results
+	if cache {
+		if msgs, ok := results.get(key); ok {
+			for _, m := range msgs {
+				if err := stream.Send(m.(*serialpb.Output)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	var (
+		mu      sync.Mutex // Output is called concurrently for global queries
+		sendErr error
+		ran     bool // this call ran the query
+	)
+	run := func() (interface{}, error) {
+		ran = true
+		versions := new(guru.Versions)
+		query := s.query(withVersions(ctx, versions), q.Location)
+		var (
+			outputs  []*serialpb.Output
+			writeErr error
+		)
+		query.Output = func(fset *token.FileSet, qr guru.QueryResult) {
+			var buf bytes.Buffer
+			err := write(&buf, fset, qr)
+			mu.Lock()
+			defer mu.Unlock()
+			if writeErr == nil {
+				writeErr = err
+			}
+			if writeErr != nil {
+				return
+			}
+			out := &serialpb.Output{Text: buf.Bytes()}
+			outputs = append(outputs, out)
+			if sendErr == nil {
+				sendErr = stream.Send(out)
+			}
+		}
+		err := guru.Run(q.Mode, query)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			return outputs, queryError(query, q.Mode, err)
+		}
+		if cache && writeErr == nil {
+			msgs := make([]message, len(outputs))
+			for i, out := range outputs {
+				msgs[i] = out
+			}
+			results.put(key, reflect.TypeOf(&serialpb.Output{}), msgs, versions)
+		}
+		return outputs, writeErr
+	}
+
+	var v interface{}
+	if cache {
+		v, err = s.coalesce(ctx, key, run)
+	} else {
+		v, err = run()
+	}
+	if !ran {
+		outputs, _ := v.([]*serialpb.Output)
+		for _, out := range outputs {
+			if sendErr == nil {
+				sendErr = stream.Send(out)
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// printer returns the function that writes a query result to w in
+// format, as accepted by Print.
+func printer(format string) (func(w io.Writer, fset *token.FileSet, qr guru.QueryResult) error, error) {
+	var (
+		json   bool
+		editor guru.Editor
+	)
+	switch format {
+	case "", "plain", "emacs":
+		editor = guru.Emacs
+	case "vim":
+		editor = guru.Vim
+	case "json":
+		json = true
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "unknown format %q", format)
+	}
+	return func(w io.Writer, fset *token.FileSet, qr guru.QueryResult) error {
+		// Output may be called by another goroutine than the query's,
+		// e.g. the loader's, out of reach of the interceptor.
+		return protect(func() error {
+			if json {
+				fmt.Fprintf(w, "%s\n", qr.JSON(fset))
+			} else {
+				guru.PrintPlain(w, fset, qr, editor)
+			}
+			return nil
+		})
+	}, nil
+}
+
+// Batch runs the queries of req concurrently and streams the output of
+// each one, or its error, as soon as it is done.  Queries of the same
+// program share it, so that it is loaded once.
+func (s *Server) Batch(req *serialpb.BatchRequest, stream serialpb.God_BatchServer) error {
+	write, err := printer(req.Format)
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+	var (
+		mu      sync.Mutex // guards stream and sendErr
+		sendErr error
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, runtime.GOMAXPROCS(0))
+	)
+	for i, item := range req.Items {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, item serialpb.BatchItem) {
+			defer wg.Done()
+			result := s.batchItem(ctx, req, item, write)
+			<-sem
+			result.Index = int64(i)
+			mu.Lock()
+			defer mu.Unlock()
+			if sendErr == nil {
+				sendErr = stream.Send(result)
+			}
+		}(i, item)
+	}
+	wg.Wait()
+	return sendErr
+}
+
+// batchItem runs the query of item, of the batch req, and returns its
+// result, unless the result is cached.
+func (s *Server) batchItem(ctx context.Context, req *serialpb.BatchRequest, item serialpb.BatchItem, write func(io.Writer, *token.FileSet, guru.QueryResult) error) *serialpb.BatchResult {
+	loc := &serialpb.Location{
+		Pos:      item.Pos,
+		Options:  req.Options,
+		Overlays: req.Overlays,
+	}
+	key, cache := flightKey("Batch", &serialpb.Query{
+		Mode:     item.Mode,
+		Location: loc,
+		Format:   req.Format,
+	})
+	results := s.workspace(req.Options).results
+	if cache {
+		if msgs, ok := results.get(key); ok {
+			return msgs[0].(*serialpb.BatchResult)
+		}
+	}
+
+	versions := new(guru.Versions)
+	query := s.query(withVersions(ctx, versions), loc)
+	var (
+		mu     sync.Mutex // Output is called concurrently for global queries
+		buf    bytes.Buffer
+		outErr error
+	)
+	query.Output = func(fset *token.FileSet, qr guru.QueryResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err := write(&buf, fset, qr); err != nil && outErr == nil {
+			outErr = err
+		}
+	}
+	// The query runs out of reach of the interceptor.
+	err := protect(func() error { return guru.Run(item.Mode, query) })
+	if err != nil {
+		err = queryError(query, item.Mode, err)
+	} else {
+		err = outErr
+	}
+	if err != nil {
+		return &serialpb.BatchResult{
+			Code:    int32(grpc.Code(err)),
+			Error:   grpc.ErrorDesc(err),
+			Details: ErrorDetails(err),
+		}
+	}
+	result := &serialpb.BatchResult{Text: buf.Bytes()}
+	if cache {
+		results.put(key, reflect.TypeOf(result), []message{result}, versions)
+	}
+	return result
 }
@@ -0,0 +1,1226 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"bytes"
+	"fmt"
+	"go/build"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode"
+
+	"github.com/zchee/god/internal/guru"
+	serialpb "github.com/zchee/god/serial"
+
+	"golang.org/x/net/context"
+	"golang.org/x/tools/go/loader"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const testSrc = `package p
+
+type T struct{ x int }
+
+func (t T) Get() int { return t.x }
+
+var a = T{x: 1}.Get()
+
+var b = "b"
+
+func f(y int) int {
+	z := y
+	return z + a
+}
+`
+
+// newTestServer returns a Server for queries on a GOPATH containing
+// package p, whose source is testSrc, and the name of p's file.  The
+// server saves its indexes in the GOPATH, which is not build.Default's.
+// The caller must call the returned cleanup function.
+func newTestServer(t *testing.T) (s *Server, filename string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "god-server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename = filepath.Join(dir, "src", "p", "p.go")
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, []byte(testSrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctxt := build.Default
+	ctxt.GOPATH = dir
+	s = newServer(&ctxt, filepath.Join(dir, "cache"))
+	return s, filename, func() {
+		s.closeWorkspaces()
+		os.RemoveAll(dir)
+	}
+}
+
+// testLocation returns the Location of the first occurrence of sel in
+// testSrc, or of the range from the first occurrence of sel to the end
+// of the first following occurrence of end.
+func testLocation(filename, sel, end string) *serialpb.Location {
+	start := strings.Index(testSrc, sel)
+	if end == "" {
+		return &serialpb.Location{Pos: fmt.Sprintf("%s:#%d", filename, start)}
+	}
+	stop := start + strings.Index(testSrc[start:], end) + len(end)
+	return &serialpb.Location{Pos: fmt.Sprintf("%s:#%d,#%d", filename, start, stop)}
+}
+
+func TestConcurrentQueries(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// The queries are answered by guru, never by the index.
+	s.defaultWorkspace.index = nil
+	s.defaultWorkspace.cache.Loaded = nil
+
+	queries := []func() error{
+		func() error {
+			desc, err := s.GetDescribe(ctx, testLocation(filename, "a =", ""))
+			if err != nil {
+				return err
+			}
+			if desc.Value == nil || desc.Value.Type != "int" {
+				return fmt.Errorf("describe a: got %+v, want type int", desc)
+			}
+			return nil
+		},
+		func() error {
+			desc, err := s.GetDescribe(ctx, testLocation(filename, "b =", ""))
+			if err != nil {
+				return err
+			}
+			if desc.Value == nil || desc.Value.Type != "string" {
+				return fmt.Errorf("describe b: got %+v, want type string", desc)
+			}
+			return nil
+		},
+		func() error {
+			def, err := s.GetDefinition(ctx, testLocation(filename, "Get()\n", ""))
+			if err != nil {
+				return err
+			}
+			if want := filename + ":5:12"; def.ObjPos != want {
+				return fmt.Errorf("definition Get: got %s, want %s", def.ObjPos, want)
+			}
+			return nil
+		},
+		func() error {
+			frs, err := s.GetFreeVars(ctx, testLocation(filename, "return z", "a"))
+			if err != nil {
+				return err
+			}
+			if len(frs.FreeVar) != 1 || frs.FreeVar[0].Ref != "z" {
+				return fmt.Errorf("freevars: got %+v, want z", frs.FreeVar)
+			}
+			return nil
+		},
+		func() error {
+			refs, err := s.GetReferrers(ctx, testLocation(filename, "a =", ""))
+			if err != nil {
+				return err
+			}
+			if len(refs.Refs) != 1 || refs.Package != "p" {
+				return fmt.Errorf("referrers a: got %+v, want one reference in p", refs)
+			}
+			return nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4*len(queries); i++ {
+		wg.Add(1)
+		go func(query func() error) {
+			defer wg.Done()
+			if err := query(); err != nil {
+				t.Error(err)
+			}
+		}(queries[i%len(queries)])
+	}
+	wg.Wait()
+}
+
+func TestCanceledQuery(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.GetDescribe(ctx, testLocation(filename, "a =", ""))
+	if code := grpc.Code(err); code != codes.Canceled {
+		t.Errorf("got error %v (code %s), want code %s", err, code, codes.Canceled)
+	}
+}
+
+// referrersStream is a God_StreamReferrersServer that records the events.
+type referrersStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*serialpb.ReferrersEvent
+}
+
+func (s *referrersStream) Context() context.Context { return s.ctx }
+
+func (s *referrersStream) Send(event *serialpb.ReferrersEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestStreamReferrers(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+
+	stream := &referrersStream{ctx: context.Background()}
+	if err := s.StreamReferrers(testLocation(filename, "a =", ""), stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.events) != 2 {
+		t.Fatalf("got %d events, want 2", len(stream.events))
+	}
+	if init := stream.events[0].Initial; init == nil || init.ObjPos != filename+":7:5" {
+		t.Errorf("got first event %+v, want the object a", stream.events[0])
+	}
+	if pkg := stream.events[1].Package; pkg == nil || pkg.Package != "p" || len(pkg.Refs) != 1 {
+		t.Errorf("got second event %+v, want one reference in p", stream.events[1])
+	} else if want := filename + ":13:13"; pkg.Refs[0].Pos != want {
+		t.Errorf("got reference at %s, want %s", pkg.Refs[0].Pos, want)
+	}
+}
+
+// dialTestServer serves s on a local port and returns a client of it.
+// The caller must call the returned cleanup function.
+func dialTestServer(t *testing.T, s *Server) (c serialpb.GodClient, cleanup func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.grpcs.Serve(lis)
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return serialpb.NewGodClient(conn), func() {
+		conn.Close()
+		s.grpcs.Stop()
+	}
+}
+
+// A goldenQuery is a query annotation of a guru test file, of the form
+// "// @verb id "select"", where select is a regular expression matching
+// the selection within the annotated line.
+type goldenQuery struct {
+	verb, id string
+	pos      string
+}
+
+var goldenQueryRe = regexp.MustCompile(`// @([a-z]+)\s+(\S+)\s+"(.*)"$`)
+
+// goldenQueries returns the queries of the named guru test file.
+func goldenQueries(t *testing.T, filename string) []goldenQuery {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var queries []goldenQuery
+	offset := 0
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		if m := goldenQueryRe.FindStringSubmatchIndex(strings.TrimSuffix(line, "\n")); m != nil {
+			sel := regexp.MustCompile(line[m[6]:m[7]])
+			loc := sel.FindStringIndex(line[:m[0]])
+			if loc == nil {
+				t.Fatalf("selection %q not found in %q", sel, line)
+			}
+			queries = append(queries, goldenQuery{
+				verb: line[m[2]:m[3]],
+				id:   line[m[4]:m[5]],
+				pos:  fmt.Sprintf("%s:#%d,#%d", filename, offset+loc[0], offset+loc[1]),
+			})
+		}
+		offset += len(line)
+	}
+	if len(queries) == 0 {
+		t.Fatalf("no queries in %s", filename)
+	}
+	return queries
+}
+
+// goldenOutputs returns the expected output of each query of the named
+// golden file, keyed by "@verb id".
+func goldenOutputs(t *testing.T, filename string) map[string]string {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs := make(map[string]string)
+	for _, section := range strings.Split(string(data), "-------- ")[1:] {
+		i := strings.Index(section, " --------\n")
+		outputs[section[:i]] = section[i+len(" --------\n"):]
+	}
+	return outputs
+}
+
+// identAt returns the identifier at pos, of the form "file:line:col".
+func identAt(t *testing.T, pos string) string {
+	filename, line, col, _ := SplitPosition(pos)
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := strings.Split(string(data), "\n")[line-1][col-1:]
+	if i := strings.IndexFunc(text, func(r rune) bool { return r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) }); i >= 0 {
+		text = text[:i]
+	}
+	return text
+}
+
+func TestWhatWhichErrsGolden(t *testing.T) {
+	gopath, err := filepath.Abs(filepath.Join("internal", "guru", "testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexDir, err := ioutil.TempDir("", "god-index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+	ctxt := build.Default
+	ctxt.GOPATH = gopath
+	s := newServer(&ctxt, indexDir)
+	defer s.closeWorkspaces()
+	c, cleanup := dialTestServer(t, s)
+	defer cleanup()
+	ctx := context.Background()
+
+	for _, pkg := range []string{"what", "whicherrs"} {
+		filename := filepath.Join(gopath, "src", pkg, "main.go")
+		golden := goldenOutputs(t, filepath.Join(gopath, "src", pkg, "main.golden"))
+		for _, q := range goldenQueries(t, filename) {
+			loc := &serialpb.Location{
+				Pos:     q.pos,
+				Options: &serialpb.Options{Scope: pkg},
+			}
+
+			// Print the result like guru's plain output.
+			var out bytes.Buffer
+			switch q.verb {
+			case "what":
+				what, err := c.GetWhat(ctx, loc)
+				if err != nil {
+					fmt.Fprintf(&out, "\nError: %s\n", grpc.ErrorDesc(err))
+					break
+				}
+				for _, node := range what.Enclosing {
+					fmt.Fprintf(&out, "%s\n", node.Description)
+				}
+				fmt.Fprintf(&out, "modes: %s\n", what.Modes)
+				fmt.Fprintf(&out, "srcdir: %s\n", strings.Replace(what.SrcDir, gopath, "testdata", 1))
+				fmt.Fprintf(&out, "import path: %s\n", what.ImportPath)
+				for range what.SameIDs {
+					fmt.Fprintf(&out, "%s\n", what.Object)
+				}
+				out.WriteString("\n")
+			case "whicherrs":
+				errs, err := c.GetWhichErrs(ctx, loc)
+				if err != nil {
+					fmt.Fprintf(&out, "\nError: %s\n", grpc.ErrorDesc(err))
+					break
+				}
+				if len(errs.Globals) > 0 {
+					fmt.Fprintf(&out, "this error may point to these globals:\n")
+					for _, pos := range errs.Globals {
+						fmt.Fprintf(&out, "\t%s\n", identAt(t, pos))
+					}
+				}
+				if len(errs.Constants) > 0 {
+					fmt.Fprintf(&out, "this error may contain these constants:\n")
+					for _, pos := range errs.Constants {
+						fmt.Fprintf(&out, "\t%s\n", identAt(t, pos))
+					}
+				}
+				if len(errs.Types) > 0 {
+					fmt.Fprintf(&out, "this error may contain these dynamic types:\n")
+					for _, typ := range errs.Types {
+						fmt.Fprintf(&out, "\t%s\n", typ.Type)
+					}
+				}
+				out.WriteString("\n")
+			}
+
+			key := fmt.Sprintf("@%s %s", q.verb, q.id)
+			if want, ok := golden[key]; !ok {
+				t.Errorf("%s: no golden output", key)
+			} else if got := out.String(); got != want {
+				t.Errorf("%s: got\n%s\nwant\n%s", key, got, want)
+			}
+		}
+	}
+}
+
+func TestLineColumnLocation(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	for _, loc := range []*serialpb.Location{
+		{Pos: filename + ":9:5"},
+		{Filename: filename, Line: 9, Col: 5},
+	} {
+		desc, err := s.GetDescribe(ctx, loc)
+		if err != nil {
+			t.Errorf("%+v: %v", loc, err)
+			continue
+		}
+		if desc.Value == nil || desc.Value.Type != "string" {
+			t.Errorf("%+v: got %+v, want b of type string", loc, desc)
+		}
+	}
+}
+
+func TestPrint(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	c, closeConn := dialTestServer(t, s)
+	defer closeConn()
+
+	query := func(mode, format string) (string, error) {
+		stream, err := c.Print(context.Background(), &serialpb.Query{
+			Mode:     mode,
+			Location: testLocation(filename, "z + a", ""),
+			Format:   format,
+		})
+		if err != nil {
+			return "", err
+		}
+		var out bytes.Buffer
+		for {
+			o, err := stream.Recv()
+			if err == io.EOF {
+				return out.String(), nil
+			}
+			if err != nil {
+				return "", err
+			}
+			out.Write(o.Text)
+		}
+	}
+
+	tests := []struct {
+		mode, format string
+		want         string
+	}{
+		{"describe", "plain", "$F:13.9-13.9: reference to var z int\n$F:12:2: defined here\n"},
+		{"describe", "emacs", "$F:13.9-13.9: reference to var z int\n$F:12:2: defined here\n"},
+		{"describe", "vim", "$F:13:9: reference to var z int\n$F:12:2: defined here\n"},
+		{"definition", "json", "{\n\t\"objpos\": \"$F:12:2\",\n\t\"desc\": \"var z\"\n}\n"},
+		{"referrers", "vim", "$F:12:2: references to var z int\n$F:13:9: \treturn z + a\n"},
+	}
+	for _, test := range tests {
+		got, err := query(test.mode, test.format)
+		if err != nil {
+			t.Errorf("%s -format=%s: %v", test.mode, test.format, err)
+			continue
+		}
+		if want := strings.Replace(test.want, "$F", filename, -1); got != want {
+			t.Errorf("%s -format=%s: got\n%s\nwant\n%s", test.mode, test.format, got, want)
+		}
+	}
+
+	if _, err := query("describe", "xml"); grpc.Code(err) != codes.InvalidArgument {
+		t.Errorf("unknown format: got error %v, want code %s", err, codes.InvalidArgument)
+	}
+}
+
+// startTestServer starts s and returns a client connected to it once it
+// listens, and the channel that receives the result of s.Start.
+func startTestServer(t *testing.T, s *Server) (c serialpb.GodClient, errc chan error, cleanup func()) {
+	errc = make(chan error, 1)
+	go func() { errc <- s.Start() }()
+	network, address := ResolveAddress(s.Addr)
+	for {
+		conn, err := net.Dial(network, address)
+		if err == nil {
+			conn.Close()
+			break
+		}
+		select {
+		case err := <-errc:
+			t.Fatalf("Start: %v", err)
+		case <-time.After(time.Millisecond):
+		}
+	}
+	conn, err := Dial(context.Background(), s.Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return serialpb.NewGodClient(conn), errc, func() { conn.Close() }
+}
+
+func TestShutdown(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	gopath := filepath.Dir(filepath.Dir(filepath.Dir(filename)))
+	s.Addr = filepath.Join(gopath, "god.sock")
+
+	c, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+
+	// A second daemon on the same address gives up.
+	s2 := newServer(s.ctxt, s.indexDir)
+	s2.Addr = s.Addr
+	if err := s2.Start(); err != ErrRunning {
+		t.Errorf("second Start: got error %v, want %v", err, ErrRunning)
+	}
+	if pid, err := ReadPID(s.Addr); err != nil || pid != os.Getpid() {
+		t.Errorf("ReadPID = %d, %v; want %d", pid, err, os.Getpid())
+	}
+
+	// The query in progress, held in the load of its program,
+	// completes despite the shutdown.
+	release := make(chan struct{})
+	cache := s.defaultWorkspace.cache
+	loaded := cache.Loaded
+	cache.Loaded = func(fset *token.FileSet, pkgs []*loader.PackageInfo) {
+		<-release
+		if loaded != nil {
+			loaded(fset, pkgs)
+		}
+	}
+	queryc := make(chan error, 1)
+	go func() {
+		_, err := c.GetDescribe(context.Background(), testLocation(filename, "a =", ""))
+		queryc <- err
+	}()
+	for {
+		s.mu.RLock()
+		active := len(s.calls)
+		s.mu.RUnlock()
+		if active > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := c.Shutdown(context.Background(), &serialpb.Request{}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errc:
+		t.Fatalf("server stopped during a query: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	if err := <-queryc; err != nil {
+		t.Errorf("query in progress: %v", err)
+	}
+	if err := <-errc; err != nil {
+		t.Errorf("Start: %v", err)
+	}
+	if _, err := os.Stat(PIDFile(s.Addr)); !os.IsNotExist(err) {
+		t.Errorf("PID file not removed: %v", err)
+	}
+}
+
+func TestIdleTimeout(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	gopath := filepath.Dir(filepath.Dir(filepath.Dir(filename)))
+	s.Addr = filepath.Join(gopath, "god.sock")
+	s.IdleTimeout = 200 * time.Millisecond
+
+	start := time.Now()
+	c, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+
+	// Each request postpones the shutdown.
+	var last time.Time
+	for i := 0; i < 3; i++ {
+		time.Sleep(s.IdleTimeout / 2)
+		if _, err := c.Ping(context.Background(), &serialpb.Request{}); err != nil {
+			t.Fatal(err)
+		}
+		last = time.Now()
+	}
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * s.IdleTimeout):
+		t.Fatal("server did not stop when idle")
+	}
+	if d := time.Since(last); d < s.IdleTimeout {
+		t.Errorf("server stopped %v after the last request, want at least %v", d, s.IdleTimeout)
+	}
+	if d := time.Since(start); d < 3*s.IdleTimeout/2 {
+		t.Errorf("server stopped after %v, before the requests ended", d)
+	}
+}
+
+func TestBuildContextWorkspaces(t *testing.T) {
+	s, _, cleanup := newTestServer(t)
+	defer cleanup()
+
+	gopath, err := ioutil.TempDir("", "god-context")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(gopath)
+	files := map[string]string{
+		"q.go":         "package q\n\nvar v = x + y\n",
+		"x_linux.go":   "package q\n\nconst x = 1\n",
+		"x_windows.go": "package q\n\nconst x = 1.5\n",
+		"y_tag.go":     "// +build tag\n\npackage q\n\nconst y = 'y'\n",
+		"y_notag.go":   "// +build !tag\n\npackage q\n\nconst y = 2\n",
+	}
+	dir := filepath.Join(gopath, "src", "q")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	filename := filepath.Join(dir, "q.go")
+	pos := fmt.Sprintf("%s:#%d", filename, strings.Index(files["q.go"], "v ="))
+
+	tests := []struct {
+		goos string
+		tags []string
+		want string // type of v
+	}{
+		{"linux", nil, "int"},
+		{"linux", []string{"tag"}, "rune"},
+		{"windows", nil, "float64"},
+		{"linux", nil, "int"}, // reuses the first workspace
+	}
+	for _, test := range tests {
+		loc := &serialpb.Location{
+			Pos: pos,
+			Options: &serialpb.Options{
+				GOPATH:    gopath, // GOROOT is that of the server
+				GOOS:      test.goos,
+				GOARCH:    "amd64",
+				BuildTags: test.tags,
+			},
+		}
+		res, err := s.GetDescribe(context.Background(), loc)
+		if err != nil {
+			t.Errorf("GOOS=%s tags=%v: %v", test.goos, test.tags, err)
+			continue
+		}
+		if got := res.Value.Type; got != test.want {
+			t.Errorf("GOOS=%s tags=%v: got type %s, want %s", test.goos, test.tags, got, test.want)
+		}
+	}
+	if n := len(s.workspaces); n != 4 {
+		t.Errorf("got %d workspaces, want 4 (default and three contexts)", n)
+	}
+}
+
+func TestNoCgoWorkspace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "god-cgo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctxt := build.Default
+	ctxt.CgoEnabled = true
+	s := newServer(&ctxt, dir)
+	defer s.closeWorkspaces()
+
+	tests := []struct {
+		opt       *serialpb.Options
+		want      bool // CgoEnabled of the workspace
+		isDefault bool // the workspace is the default one
+	}{
+		{&serialpb.Options{}, true, true},
+		{&serialpb.Options{CgoEnabled: false}, true, true}, // not set
+		{&serialpb.Options{CgoEnabled: false, CgoEnabledSet: true}, false, false},
+		{&serialpb.Options{GOOS: "windows"}, true, false},
+	}
+	for _, test := range tests {
+		w := s.workspace(test.opt)
+		if w.ctxt.CgoEnabled != test.want {
+			t.Errorf("options %+v: got CgoEnabled %t, want %t", test.opt, w.ctxt.CgoEnabled, test.want)
+		}
+		if test.opt.GOOS == "" && w.ctxt.GOOS != ctxt.GOOS {
+			t.Errorf("options %+v: got GOOS %s, want that of the server %s", test.opt, w.ctxt.GOOS, ctxt.GOOS)
+		}
+		if (w == s.defaultWorkspace) != test.isDefault {
+			t.Errorf("options %+v: got default workspace %t, want %t", test.opt, w == s.defaultWorkspace, test.isDefault)
+		}
+	}
+}
+
+func TestWorkspaceEviction(t *testing.T) {
+	s, _, cleanup := newTestServer(t)
+	defer cleanup()
+
+	var first *workspace
+	for i := 0; i < maxWorkspaces; i++ {
+		w := s.workspace(&serialpb.Options{GOPATH: fmt.Sprintf("%s/%d", s.ctxt.GOPATH, i)})
+		if w.ctxt.GOROOT != s.ctxt.GOROOT || w.ctxt.GOOS != s.ctxt.GOOS {
+			t.Errorf("workspace of a GOPATH has context %+v, want that of the server otherwise", w.ctxt)
+		}
+		if first == nil {
+			first = w
+		}
+		time.Sleep(time.Millisecond) // order the uses
+	}
+	if n := len(s.workspaces); n != maxWorkspaces {
+		t.Fatalf("got %d workspaces, want %d", n, maxWorkspaces)
+	}
+	for _, w := range s.workspaces {
+		if w == first {
+			t.Errorf("the least recently used workspace was not closed")
+		}
+	}
+	if s.workspace(nil) != s.defaultWorkspace || s.workspace(&serialpb.Options{GOPATH: s.ctxt.GOPATH}) != s.defaultWorkspace {
+		t.Errorf("the default workspace was closed")
+	}
+}
+
+func TestStatus(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	gopath := filepath.Dir(filepath.Dir(filepath.Dir(filename)))
+	s.Addr = filepath.Join(gopath, "god.sock")
+
+	c, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+	defer func() {
+		s.Stop()
+		<-errc
+	}()
+	ctx := context.Background()
+
+	// A query held in the load of its program is in progress.
+	release := make(chan struct{})
+	cache := s.defaultWorkspace.cache
+	loaded := cache.Loaded
+	cache.Loaded = func(fset *token.FileSet, pkgs []*loader.PackageInfo) {
+		<-release
+		if loaded != nil {
+			loaded(fset, pkgs)
+		}
+	}
+	loc := testLocation(filename, "a =", "")
+	stream, err := c.Print(ctx, &serialpb.Query{Mode: "describe", Location: loc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var st *serialpb.DaemonStatus
+	for {
+		if st, err = c.Status(ctx, &serialpb.Request{}); err != nil {
+			t.Fatal(err)
+		}
+		if len(st.Calls) > 0 && st.Calls[0].Pos != "" && len(st.Workspaces[0].Programs) > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if st.Version != Version || st.Pid != int64(os.Getpid()) || st.Uptime <= 0 || st.HeapAlloc == 0 {
+		t.Errorf("got status %+v", st)
+	}
+	if got, want := st.Calls, []serialpb.CallStatus{{Method: "Print", Mode: "describe", Pos: loc.Pos}}; len(got) != 1 ||
+		got[0].Method != want[0].Method || got[0].Mode != want[0].Mode || got[0].Pos != want[0].Pos || got[0].Elapsed <= 0 {
+		t.Errorf("got calls %+v, want %+v", got, want)
+	}
+	if len(st.Workspaces) != 1 || st.Workspaces[0].GOPATH != gopath {
+		t.Fatalf("got workspaces %+v, want that of GOPATH %s", st.Workspaces, gopath)
+	}
+	if progs := st.Workspaces[0].Programs; len(progs) != 1 || !progs[0].Loading {
+		t.Errorf("got programs %+v, want one loading", progs)
+	}
+	close(release)
+	for {
+		if _, err := stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The same query hits the program cache, and a query held in the
+	// reload of the program hits the index once it is updated.
+	for s.defaultWorkspace.index.Len() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := c.GetDescribe(ctx, loc); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetDefinition(ctx, loc); err != nil {
+		t.Fatal(err)
+	}
+	release = make(chan struct{})
+	cache.Invalidate([]string{"p"})
+	def, err := c.GetDefinition(ctx, testLocation(filename, "Get()\n", ""))
+	close(release)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filename + ":5:12"; def.ObjPos != want {
+		t.Errorf("got definition at %s from the index, want %s", def.ObjPos, want)
+	}
+	if st, err = c.Status(ctx, &serialpb.Request{}); err != nil {
+		t.Fatal(err)
+	}
+	ws := st.Workspaces[0]
+	if len(st.Calls) != 0 {
+		t.Errorf("got calls %+v after the queries, want none", st.Calls)
+	}
+	if ws.ProgramCache.Hits == 0 || ws.ProgramCache.Misses == 0 {
+		t.Errorf("got program cache counters %+v, want hits and misses", ws.ProgramCache)
+	}
+	if ws.Index.Hits != 1 || ws.IndexedFiles != 1 {
+		t.Errorf("got index counters %+v for %d files, want 1 hit for 1 file", ws.Index, ws.IndexedFiles)
+	}
+	found := false
+	for _, prog := range ws.Programs {
+		if prog.Loading || prog.Packages == 0 {
+			t.Errorf("got program %+v, want a loaded one", prog)
+		}
+		found = found || len(prog.Scope) == 1 && prog.Scope[0] == "p"
+	}
+	if !found {
+		t.Errorf("got programs %+v, want one of package p", ws.Programs)
+	}
+}
+
+func TestDebugHTTP(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	s.Addr = filepath.Join(filepath.Dir(filepath.Dir(filepath.Dir(filename))), "god.sock")
+	s.DebugAddr = "127.0.0.1:0"
+
+	c, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+	defer func() {
+		s.Stop()
+		<-errc
+	}()
+	if _, err := c.GetDescribe(context.Background(), testLocation(filename, "a =", "")); err != nil {
+		t.Fatal(err)
+	}
+
+	s.mu.RLock()
+	addr := s.debug
+	s.mu.RUnlock()
+	get := func(path string) string {
+		resp, err := http.Get("http://" + addr.String() + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("GET %s: %s", path, resp.Status)
+		}
+		return string(body)
+	}
+	if body := get("/debug/requests?fam=grpc.Recv.God&b=0&exp=1"); !strings.Contains(body, "GetDescribe") ||
+		!strings.Contains(body, "load: type-checked") {
+		t.Errorf("/debug/requests has no trace of the describe query and its load:\n%s", body)
+	}
+	if body := get("/debug/events?fam=god.Server&b=0&exp=1"); !strings.Contains(body, "start: unix "+s.Addr) {
+		t.Errorf("/debug/events has no start event of the server:\n%s", body)
+	}
+	if body := get("/debug/pprof/"); !strings.Contains(body, "heap") {
+		t.Errorf("/debug/pprof/ has no heap profile:\n%s", body)
+	}
+
+	// Profiles, like traces, are not served to remote clients.
+	w := httptest.NewRecorder()
+	debugHandler().ServeHTTP(w, httptest.NewRequest("GET", "/debug/pprof/cmdline", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("GET /debug/pprof/cmdline from a remote client: got status %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+// brokenSrc is a package with syntax and type errors.
+const brokenSrc = `package broken
+
+import "nonexistent/pkg"
+
+type T struct{ x undefined }
+
+func (t T) M() int { return t.x.y + pkg.Z }
+
+func main() {
+	var ch chan int
+	ch <- "s"
+	t := T{}
+	t.M(
+}
+`
+
+// writeBroken adds package broken, whose source is brokenSrc, to gopath
+// and returns the name of its file.
+func writeBroken(t *testing.T, gopath string) string {
+	filename := filepath.Join(gopath, "src", "broken", "broken.go")
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, []byte(brokenSrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestBrokenPackage(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	gopath := filepath.Dir(filepath.Dir(filepath.Dir(filename)))
+	s.Addr = filepath.Join(gopath, "god.sock")
+	broken := writeBroken(t, gopath)
+
+	c, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+	defer func() {
+		s.Stop()
+		<-errc
+	}()
+	ctx := context.Background()
+
+	// Every mode fails with an error of its query, not a crash.
+	for _, mode := range []string{
+		"callees", "callers", "callstack", "definition", "describe", "freevars",
+		"implements", "peers", "pointsto", "referrers", "what", "whicherrs",
+	} {
+		for _, sel := range []string{"broken", "pkg", "T struct", "x undefined", "M()", "t.x.y", "ch <-", "t.M("} {
+			pos := fmt.Sprintf("%s:#%d", broken, strings.Index(brokenSrc, sel))
+			for _, format := range []string{"plain", "json"} {
+				stream, err := c.Print(ctx, &serialpb.Query{
+					Mode:     mode,
+					Location: &serialpb.Location{Pos: pos, Options: &serialpb.Options{Scope: "broken"}},
+					Format:   format,
+				})
+				for err == nil {
+					_, err = stream.Recv()
+				}
+				if err != io.EOF && grpc.Code(err) == codes.Internal {
+					t.Errorf("%s %q in %s: got error %v, want a query error", mode, sel, format, err)
+				}
+			}
+		}
+	}
+	if _, err := c.Ping(ctx, &serialpb.Request{}); err != nil {
+		t.Errorf("Ping after the queries: %v", err)
+	}
+
+	// No package imports broken, so the loader never sees it when it
+	// looks for the referrers of the package.
+	loc := &serialpb.Location{Pos: fmt.Sprintf("%s:#%d", broken, strings.Index(brokenSrc, "broken"))}
+	want := `query package "broken" not found during reloading`
+	if _, err := c.GetReferrers(ctx, loc); grpc.ErrorDesc(err) != want {
+		t.Errorf("GetReferrers of the package: got error %v, want %q", err, want)
+	}
+}
+
+func TestPanicRecovery(t *testing.T) {
+	s := NewServer()
+	info := &grpc.UnaryServerInfo{FullMethod: "/serial.God/GetWhat"}
+	_, err := s.unaryInterceptor(context.Background(), &serialpb.Location{}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("bad query")
+	})
+	if grpc.Code(err) != codes.Internal || !strings.Contains(grpc.ErrorDesc(err), "bad query") {
+		t.Errorf("got error %v, want an internal error of the panic", err)
+	}
+	if len(s.calls) != 0 {
+		t.Errorf("the call is still in progress after its panic")
+	}
+}
+
+// TestPanicRecoveryGoroutine checks that a panic on a goroutine of a
+// query, here one of the loader on which a global referrers query calls
+// Output, also fails the call with an internal error.
+func TestPanicRecoveryGoroutine(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	info := &grpc.UnaryServerInfo{FullMethod: "/serial.God/GetReferrers"}
+	loc := testLocation(filename, "T struct", "")
+	_, err := s.unaryInterceptor(context.Background(), loc, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		query := s.query(ctx, loc)
+		query.Output = func(*token.FileSet, guru.QueryResult) {
+			panic("bad output")
+		}
+		return nil, queryError(query, "referrers", guru.Referrers(query))
+	})
+	if grpc.Code(err) != codes.Internal || !strings.Contains(grpc.ErrorDesc(err), "bad output") {
+		t.Errorf("got error %v, want an internal error of the panic", err)
+	}
+}
+
+func TestQueryMethods(t *testing.T) {
+	// The unary methods of a Location are queries.
+	server := reflect.TypeOf((*serialpb.GodServer)(nil)).Elem()
+	loc := reflect.TypeOf((*serialpb.Location)(nil))
+	want := make(map[string]bool)
+	for i := 0; i < server.NumMethod(); i++ {
+		m := server.Method(i)
+		if m.Type.NumIn() == 2 && m.Type.In(1) == loc && m.Type.NumOut() == 2 {
+			want["/serial.God/"+m.Name] = true
+		}
+	}
+	if !reflect.DeepEqual(queryMethods, want) {
+		t.Errorf("got query methods %v, want %v", queryMethods, want)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	s.Addr = filepath.Join(filepath.Dir(filepath.Dir(filepath.Dir(filename))), "god.sock")
+
+	_, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+	defer func() {
+		s.Stop()
+		<-errc
+	}()
+	ctx := context.Background()
+	conn, err := Dial(ctx, s.Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(conn)
+	defer c.Close()
+
+	items := []serialpb.BatchItem{
+		{Mode: "describe", Pos: testLocation(filename, "a =", "").Pos},
+		{Mode: "describe", Pos: testLocation(filename, "b =", "").Pos},
+		{Mode: "describe", Pos: testLocation(filename, "z :=", "").Pos},
+		{Mode: "nonesuch", Pos: testLocation(filename, "a =", "").Pos},
+		{Mode: "definition", Pos: testLocation(filename, "var a", "").Pos},
+	}
+	results, err := c.Batch(ctx, items, "plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []string{"definition of var a int", "definition of var b string", "definition of var z int"} {
+		if got := results[i]; got.Code != 0 || !strings.Contains(string(got.Text), want) {
+			t.Errorf("result %d: got %+v, want output with %q", i, got, want)
+		}
+	}
+	if got := results[3]; codes.Code(got.Code) != codes.InvalidArgument || got.Error != `invalid mode: "nonesuch"` {
+		t.Errorf("result of an invalid mode: got %+v", got)
+	}
+	if got := results[4]; codes.Code(got.Code) != codes.InvalidArgument || got.Details == nil || len(got.Details.Modes) == 0 {
+		t.Errorf("result of a definition without identifier: got %+v, want an error with suggested modes", got)
+	}
+
+	// The describe queries share a program.
+	if stats := s.defaultWorkspace.cache.Stats(); stats.Loads != 1 || stats.Hits != 2 {
+		t.Errorf("got %d program loads and %d hits, want 1 and 2", stats.Loads, stats.Hits)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	s.Addr = filepath.Join(filepath.Dir(filepath.Dir(filepath.Dir(filename))), "god.sock")
+
+	c, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+	defer func() {
+		s.Stop()
+		<-errc
+	}()
+	ctx := context.Background()
+
+	// The queries wait for the load of their program.
+	release := make(chan struct{})
+	s.defaultWorkspace.cache.Loaded = func(*token.FileSet, []*loader.PackageInfo) { <-release }
+
+	loc := testLocation(filename, "a =", "")
+	var (
+		wg       sync.WaitGroup
+		describe [2]*serialpb.Describe
+		print    [2]string
+	)
+	for i := range describe {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			if describe[i], err = c.GetDescribe(ctx, loc); err != nil {
+				t.Errorf("GetDescribe: %v", err)
+			}
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			stream, err := c.Print(ctx, &serialpb.Query{Mode: "describe", Location: loc})
+			for err == nil {
+				var out *serialpb.Output
+				if out, err = stream.Recv(); err == nil {
+					print[i] += string(out.Text)
+				}
+			}
+			if err != io.EOF {
+				t.Errorf("Print: %v", err)
+			}
+		}(i)
+	}
+	for s.flight.Coalesced() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if describe[0] == nil || describe[1] == nil || describe[0].Desc != describe[1].Desc {
+		t.Errorf("got descriptions %+v and %+v, want the same", describe[0], describe[1])
+	}
+	if print[0] == "" || print[0] != print[1] {
+		t.Errorf("got outputs %q and %q, want the same", print[0], print[1])
+	}
+	st, err := c.Status(ctx, &serialpb.Request{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Coalesced != 2 {
+		t.Errorf("got %d coalesced requests, want 2", st.Coalesced)
+	}
+
+	// Queries that are not in progress together are not coalesced.
+	if _, err := c.GetDescribe(ctx, loc); err != nil {
+		t.Fatal(err)
+	}
+	if n := s.flight.Coalesced(); n != 2 {
+		t.Errorf("got %d coalesced requests after a lone one, want 2", n)
+	}
+}
+
+func TestResultCache(t *testing.T) {
+	s, filename, cleanup := newTestServer(t)
+	defer cleanup()
+	s.Addr = filepath.Join(filepath.Dir(filepath.Dir(filepath.Dir(filename))), "god.sock")
+
+	c, errc, closeConn := startTestServer(t, s)
+	defer closeConn()
+	defer func() {
+		s.Stop()
+		<-errc
+	}()
+	ctx := context.Background()
+
+	describe := func(loc *serialpb.Location) *serialpb.Describe {
+		d, err := c.GetDescribe(ctx, loc)
+		if err != nil {
+			t.Fatalf("GetDescribe: %v", err)
+		}
+		return d
+	}
+	print := func(q *serialpb.Query) (text string, cached bool) {
+		stream, err := c.Print(ctx, q)
+		cached = true
+		for err == nil {
+			var out *serialpb.Output
+			if out, err = stream.Recv(); err == nil {
+				text += string(out.Text)
+				cached = cached && out.Cached
+			}
+		}
+		if err != io.EOF {
+			t.Fatalf("Print: %v", err)
+		}
+		return text, cached
+	}
+	batch := func(req *serialpb.BatchRequest) (cached int) {
+		stream, err := c.Batch(ctx, req)
+		for err == nil {
+			var result *serialpb.BatchResult
+			if result, err = stream.Recv(); err == nil && result.Cached {
+				cached++
+			}
+		}
+		if err != io.EOF {
+			t.Fatalf("Batch: %v", err)
+		}
+		return cached
+	}
+
+	loc := testLocation(filename, "a =", "")
+	if d := describe(loc); d.Cached {
+		t.Errorf("first describe: got a cached response %+v", d)
+	}
+	if d := describe(loc); !d.Cached || d.Value == nil || d.Value.Type != "int" {
+		t.Errorf("second describe: got %+v, want the cached description of a value of type int", d)
+	}
+
+	q := &serialpb.Query{Mode: "describe", Location: loc, Format: "json"}
+	text, cached := print(q)
+	if cached {
+		t.Errorf("first print: got a cached output %q", text)
+	}
+	if text2, cached := print(q); !cached || text2 != text {
+		t.Errorf("second print: got %q, cached %t, want %q, cached", text2, cached, text)
+	}
+
+	// Other options make for another response.
+	other := *loc
+	other.Options = &serialpb.Options{Reflection: true}
+	if d := describe(&other); d.Cached {
+		t.Errorf("describe with other options: got a cached response %+v", d)
+	}
+
+	req := &serialpb.BatchRequest{Items: []serialpb.BatchItem{
+		{Mode: "describe", Pos: testLocation(filename, "b =", "").Pos},
+		{Mode: "nonesuch", Pos: loc.Pos},
+	}}
+	if n := batch(req); n != 0 {
+		t.Errorf("first batch: got %d cached results, want 0", n)
+	}
+	if n := batch(req); n != 1 {
+		t.Errorf("second batch: got %d cached results, want 1, errors are not cached", n)
+	}
+
+	// The watcher reports a change of package p.
+	s.defaultWorkspace.results.invalidate([]string{"p"})
+	if d := describe(loc); d.Cached {
+		t.Errorf("describe after invalidation: got a cached response %+v", d)
+	}
+
+	// A change of the file is noticed even before the watcher reports it.
+	bloc := testLocation(filename, "b =", "")
+	describe(bloc)
+	src := strings.Replace(testSrc, `var b = "b"`, `var b = 2.0`, 1)
+	if err := ioutil.WriteFile(filename, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if d := describe(bloc); d.Cached || d.Value == nil || d.Value.Type != "float64" {
+		t.Errorf("describe after a change: got %+v, want the uncached description of a value of type float64", d)
+	}
+
+	st, err := c.Status(ctx, &serialpb.Request{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := st.Workspaces[0].ResultCache; got.Hits != 3 {
+		t.Errorf("got %d result cache hits, want 3", got.Hits)
+	}
+}
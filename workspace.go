@@ -0,0 +1,254 @@
+// Copyright 2017 The god Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package god
+
+import (
+	"go/build"
+	"go/token"
+	"sync/atomic"
+	"time"
+
+	"github.com/zchee/god/internal/buildkey"
+	"github.com/zchee/god/internal/guru"
+	"github.com/zchee/god/internal/index"
+	"github.com/zchee/god/internal/log"
+	"github.com/zchee/god/internal/watcher"
+	serialpb "github.com/zchee/god/serial"
+
+	"golang.org/x/tools/go/loader"
+)
+
+// maxWorkspaces is the number of workspaces a Server keeps, the default
+// one included, before it closes the least recently used one.
+const maxWorkspaces = 8
+
+// A workspace holds the state of the server for one build context, so
+// that queries with different GOPATHs, platforms or build tags never
+// share a program.
+type workspace struct {
+	ctxt     *build.Context
+	cache    *guru.Cache      // loaded programs shared by all queries
+	results  *resultCache     // responses of the queries
+	watcher  *watcher.Watcher // invalidates cache and results on file changes
+	index    *index.Index     // definitions and references of the workspace, or nil
+	indexing int32            // updates of index in progress; accessed atomically
+	used     int64            // UnixNano of the last query; accessed atomically
+}
+
+// newWorkspace returns the workspace of ctxt, whose index is saved in
+// indexDir.
+func newWorkspace(ctxt *build.Context, indexDir string) *workspace {
+	w := &workspace{
+		ctxt:    ctxt,
+		cache:   guru.NewCache(),
+		results: newResultCache(),
+	}
+	if idx, err := index.Open(indexDir, ctxt); err != nil {
+		log.Debugf("index: %v", err)
+	} else {
+		w.index = idx
+		w.cache.Loaded = func(fset *token.FileSet, pkgs []*loader.PackageInfo) {
+			go w.updateIndex(fset, pkgs)
+		}
+	}
+
+	w.watcher = watcher.New(ctxt, func(pkgs []string) {
+		w.cache.Invalidate(pkgs)
+		w.results.invalidate(pkgs)
+		if w.index != nil {
+			w.index.InvalidateGraph() // the imports may have changed
+		}
+	})
+	w.cache.Watch = func(dirs []string) {
+		if err := w.watcher.Add(dirs...); err != nil {
+			log.Debugf("watch: %v", err)
+		}
+	}
+	return w
+}
+
+// close stops watching the files of the workspace and saves its index.
+func (w *workspace) close() {
+	w.watcher.Close()
+	if w.index != nil {
+		if err := w.index.Save(); err != nil {
+			log.Debugf("index: %v", err)
+		}
+	}
+}
+
+// warmIndex indexes again the packages whose files changed since the
+// index was saved, e.g. while the daemon was not running.
+func (w *workspace) warmIndex() {
+	if w.index == nil {
+		return
+	}
+	atomic.AddInt32(&w.indexing, 1)
+	defer atomic.AddInt32(&w.indexing, -1)
+	pkgs := w.index.Stale()
+	log.Debugf("index: %d files, %d stale packages", w.index.Len(), len(pkgs))
+	if len(pkgs) > 0 {
+		lconf := loader.Config{Build: w.ctxt, AllowErrors: true}
+		lconf.TypeChecker.Error = func(err error) {}
+		for _, path := range pkgs {
+			lconf.ImportWithTests(path)
+		}
+		lprog, err := lconf.Load()
+		if err != nil {
+			log.Debugf("index: %v", err)
+			return
+		}
+		var infos []*loader.PackageInfo
+		for _, info := range lprog.InitialPackages() {
+			infos = append(infos, info)
+		}
+		w.updateIndex(lprog.Fset, infos)
+	}
+	if err := w.index.Save(); err != nil {
+		log.Debugf("index: %v", err)
+	}
+}
+
+// updateIndex adds the files of pkgs to the index and saves it.
+func (w *workspace) updateIndex(fset *token.FileSet, pkgs []*loader.PackageInfo) {
+	atomic.AddInt32(&w.indexing, 1)
+	defer atomic.AddInt32(&w.indexing, -1)
+	if w.index.Update(fset, pkgs) == 0 {
+		return
+	}
+	if err := w.index.Save(); err != nil {
+		log.Debugf("index: %v", err)
+	}
+}
+
+// status describes the caches of w.
+func (w *workspace) status() serialpb.WorkspaceStatus {
+	stats := w.cache.Stats()
+	st := serialpb.WorkspaceStatus{
+		GOROOT:     w.ctxt.GOROOT,
+		GOPATH:     w.ctxt.GOPATH,
+		GOOS:       w.ctxt.GOOS,
+		GOARCH:     w.ctxt.GOARCH,
+		BuildTags:  w.ctxt.BuildTags,
+		CgoEnabled: w.ctxt.CgoEnabled,
+		ProgramCache: serialpb.Counters{
+			Hits:   int64(stats.Hits),
+			Misses: int64(stats.Loads + stats.Reloads),
+		},
+		Reloads: int64(stats.Reloads),
+		Rebuilt: int64(stats.Rebuilt),
+		AnalysisCache: serialpb.Counters{
+			Hits:   int64(stats.AnalysisHits),
+			Misses: int64(stats.Analyses),
+		},
+		Indexing: atomic.LoadInt32(&w.indexing) > 0,
+	}
+	for _, prog := range w.cache.Programs() {
+		st.Programs = append(st.Programs, serialpb.ProgramStatus{
+			Scope:           prog.Scope,
+			Packages:        int64(prog.Packages),
+			Loading:         prog.Loading,
+			SSA:             prog.SSA,
+			PointerAnalysis: prog.PointerAnalysis,
+		})
+	}
+	st.ResultCache.Hits, st.ResultCache.Misses = w.results.stats()
+	if w.index != nil {
+		st.IndexedFiles = int64(w.index.Len())
+		st.Index.Hits, st.Index.Misses = w.index.Stats()
+	}
+	return st
+}
+
+// buildContext returns the build context requested by opt: that of the
+// server with the fields that opt sets, those that are not empty, and
+// CgoEnabled if opt.CgoEnabledSet.
+func (s *Server) buildContext(opt *serialpb.Options) *build.Context {
+	if opt == nil {
+		return s.ctxt
+	}
+	ctxt := *s.ctxt
+	if opt.GOROOT != "" {
+		ctxt.GOROOT = opt.GOROOT
+	}
+	if opt.GOPATH != "" {
+		ctxt.GOPATH = opt.GOPATH
+	}
+	if opt.GOOS != "" {
+		ctxt.GOOS = opt.GOOS
+	}
+	if opt.GOARCH != "" {
+		ctxt.GOARCH = opt.GOARCH
+	}
+	if opt.CgoEnabledSet {
+		ctxt.CgoEnabled = opt.CgoEnabled
+	}
+	if opt.BuildTags != nil {
+		ctxt.BuildTags = opt.BuildTags
+	}
+	return &ctxt
+}
+
+// workspace returns the workspace of the build context requested by
+// opt, creating it on first use.
+func (s *Server) workspace(opt *serialpb.Options) *workspace {
+	ctxt := s.buildContext(opt)
+	key := buildkey.Key(ctxt)
+	w := s.defaultWorkspace
+	if ctxt != s.ctxt {
+		s.mu.RLock()
+		w = s.workspaces[key]
+		s.mu.RUnlock()
+	}
+	if w == nil {
+		w = s.newWorkspace(ctxt, key)
+	}
+	atomic.StoreInt64(&w.used, time.Now().UnixNano())
+	return w
+}
+
+// newWorkspace returns the workspace of ctxt, whose key is key, creating
+// it unless another query just did.  It closes the least recently used
+// workspace if the server has too many.
+func (s *Server) newWorkspace(ctxt *build.Context, key string) *workspace {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if w := s.workspaces[key]; w != nil {
+		return w
+	}
+	log.Debugf("new workspace: %s", key)
+	s.events.Printf("new workspace: %s", key)
+	w := newWorkspace(ctxt, s.indexDir)
+	w.used = time.Now().UnixNano()
+	s.workspaces[key] = w
+	go w.warmIndex()
+
+	for len(s.workspaces) > maxWorkspaces {
+		var oldest string
+		var used int64
+		for key, w := range s.workspaces {
+			if w == s.defaultWorkspace {
+				continue
+			}
+			if t := atomic.LoadInt64(&w.used); oldest == "" || t < used {
+				oldest, used = key, t
+			}
+		}
+		log.Debugf("close workspace: %s", oldest)
+		s.events.Printf("close workspace: %s", oldest)
+		s.workspaces[oldest].close()
+		delete(s.workspaces, oldest)
+	}
+	return w
+}
+
+// closeWorkspaces closes all workspaces of the server.
+func (s *Server) closeWorkspaces() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, w := range s.workspaces {
+		w.close()
+	}
+}